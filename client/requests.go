@@ -207,6 +207,45 @@ func (c *BaseClient) Delete(ctx context.Context, path string) error {
 	return nil
 }
 
+// DeleteWithBody sends a DELETE request to API and returns response body as []bytes.
+// It is used by the bulk endpoints, which answer with a job status instead of No Content.
+func (c *BaseClient) DeleteWithBody(ctx context.Context, path string) ([]byte, error) {
+	req, err := http.NewRequest(http.MethodDelete, c.BaseURL.String()+path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req = c.PrepareRequest(ctx, req)
+
+	resp, err := c.HttpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		if c.ClientRetry {
+			duration := GetRetryWaitTime(resp)
+			WaitForRetry(ctx, duration)
+			return c.DeleteWithBody(ctx, path)
+		} else {
+			return nil, NewError(body, resp)
+		}
+	}
+
+	if !(resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusAccepted ||
+		resp.StatusCode == http.StatusNoContent) {
+		return nil, NewError(body, resp)
+	}
+
+	return body, nil
+}
+
 // GetData is a generic helper function that retrieves and unmarshals JSON data from a specified URL.
 // It takes four parameters:
 // - a pointer to a BaseClient (z) which is used to execute the GET request,
@@ -1,284 +1,195 @@
+// Command codegen generates the zendesk package code described by resources.yaml:
+// list iterators, endpoint specific iterator options, CRUD methods, the GeneratedAPI
+// interface, table driven tests and any missing test fixtures.
+//
+// Run it from the repository root:
+//
+//	go run ./script/codegen         // regenerate
+//	go run ./script/codegen -check  // fail if generated files are stale
 package main
 
 import (
+	"bytes"
+	"errors"
+	"flag"
 	"fmt"
-	"html/template"
+	"go/format"
 	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+	"unicode"
 )
 
-var tpl = `
-// Code generated by Script. DO NOT EDIT.
-// Source: script/codegen/main.go
-//
-// Generated by this command:
-//
-//	go run script/codegen/main.go
+var funcs = template.FuncMap{
+	"lower": func(s string) string {
+		r := []rune(s)
+		r[0] = unicode.ToLower(r[0])
+		return string(r)
+	},
+	"join": func(values []string) string {
+		return strings.Join(values, ", ")
+	},
+}
 
-package zendesk
-{{ if .ExtraParam }}
-import (
-	"context"
-	"fmt"
+var (
+	resourceTemplate = template.Must(template.New("resource").Funcs(funcs).Parse(resourceTpl))
+	apiTemplate      = template.Must(template.New("api").Funcs(funcs).Parse(apiTpl))
+	testTemplate     = template.Must(template.New("test").Funcs(funcs).Parse(testTpl))
 )
-{{ else }}
-import "context"
-{{ end }}
-func (z *Client) Get{{.FuncName}}Iterator(ctx context.Context, opts *PaginationOptions) *Iterator[{{.ObjectName}}] {
-	return &Iterator[{{.ObjectName}}]{
-		CommonOptions: opts.CommonOptions,
-		pageSize:      opts.PageSize,
-		hasMore:       true,
-		isCBP:         opts.IsCBP,
-		pageAfter:     "",
-		pageIndex:     1,
-		ctx:           ctx,
-		obpFunc:       z.Get{{.FuncName}}OBP,
-		cbpFunc:       z.Get{{.FuncName}}CBP,
-	}
-}
 
-func (z *Client) Get{{.FuncName}}OBP(ctx context.Context, opts *OBPOptions) ([]{{.ObjectName}}, Page, error) {
-	var data struct {
-		{{.ObjectName}}s []{{.ObjectName}} ` + "`json:\"{{.JsonName}}\"`" + `
-		Page
-	}
+func main() {
+	dir := flag.String("dir", ".", "repository root")
+	specPath := flag.String("spec", "script/codegen/resources.yaml", "resource spec, relative to -dir")
+	check := flag.Bool("check", false, "report stale generated files instead of writing them")
+	flag.Parse()
 
-	tmp := opts
-	if tmp == nil {
-		tmp = &OBPOptions{}
-	}
-	{{ if .ExtraParam }}
-	path := fmt.Sprintf("{{.ApiEndpoint}}", tmp.Id)
-	u, err := addOptions(path, tmp)
-	{{ else }}
-	u, err := addOptions("{{.ApiEndpoint}}", tmp)
-	{{ end }}
+	spec, err := loadSpec(filepath.Join(*dir, *specPath))
 	if err != nil {
-		return nil, Page{}, err
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
 	}
 
-	err = getData(z, ctx, u, &data)
+	files, err := generate(spec)
 	if err != nil {
-		return nil, Page{}, err
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	if *check {
+		stale, err := staleFiles(*dir, files, fixtures(spec))
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		if len(stale) > 0 {
+			fmt.Fprintf(os.Stderr, "generated files are stale, run `go run ./script/codegen`:\n  %s\n",
+				strings.Join(stale, "\n  "))
+			os.Exit(1)
+		}
+		return
+	}
+
+	if err := write(*dir, files, fixtures(spec)); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
 	}
-	return data.{{.ObjectName}}s, data.Page, nil
 }
 
-func (z *Client) Get{{.FuncName}}CBP(ctx context.Context, opts *CBPOptions) ([]{{.ObjectName}}, CursorPaginationMeta, error) {
-	var data struct {
-		{{.ObjectName}}s []{{.ObjectName}} ` + "`json:\"{{.JsonName}}\"`" + `
-		Meta    CursorPaginationMeta ` + "`json:\"meta\"`" + `
+// generate renders every generated Go file, keyed by path relative to the repository root
+func generate(spec *Spec) (map[string][]byte, error) {
+	files := map[string][]byte{}
+
+	for _, r := range spec.Resources {
+		b, err := render(resourceTemplate, r)
+		if err != nil {
+			return nil, fmt.Errorf("resource %q: %w", r.Name, err)
+		}
+		files[filepath.Join("zendesk", r.File+"_generated.go")] = b
 	}
 
-	tmp := opts
-	if tmp == nil {
-		tmp = &CBPOptions{}
+	b, err := render(apiTemplate, spec)
+	if err != nil {
+		return nil, fmt.Errorf("api: %w", err)
 	}
-	{{ if .ExtraParam }}
-	path := fmt.Sprintf("{{.ApiEndpoint}}", tmp.Id)
-	u, err := addOptions(path, tmp)
-	{{ else }}
-	u, err := addOptions("{{.ApiEndpoint}}", tmp)
-	{{ end }}
+	files[filepath.Join("zendesk", "api_generated.go")] = b
+
+	b, err = render(testTemplate, spec)
 	if err != nil {
-		return nil, data.Meta, err
+		return nil, fmt.Errorf("test: %w", err)
 	}
+	files[filepath.Join("zendesk", "api_generated_test.go")] = b
 
-	err = getData(z, ctx, u, &data)
+	return files, nil
+}
+
+func render(tpl *template.Template, data interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := tpl.Execute(&buf, data); err != nil {
+		return nil, err
+	}
+	b, err := format.Source(buf.Bytes())
 	if err != nil {
-		return nil, data.Meta, err
+		return nil, fmt.Errorf("%w\n%s", err, buf.String())
 	}
-	return data.{{.ObjectName}}s, data.Meta, nil
+	return b, nil
 }
 
-`
+// fixtures returns the placeholder content of every fixture referenced by the spec,
+// keyed by path relative to the repository root. Existing fixtures are never overwritten.
+func fixtures(spec *Spec) map[string][]byte {
+	methods := map[string]string{
+		VerbList: "GET", VerbShow: "GET", VerbCreate: "POST", VerbUpdate: "PUT",
+	}
 
-type FuncTemplateData struct {
-	FuncName    string
-	ObjectName  string
-	ApiEndpoint string
-	JsonName    string
-	FileName    string
-	ExtraParam  bool
+	result := map[string][]byte{}
+	for _, r := range spec.Resources {
+		for verb, name := range r.Fixtures {
+			var content string
+			if verb == VerbList {
+				content = fmt.Sprintf(
+					"{\n  %q: [\n    {\n      \"id\": %s\n    }\n  ],\n  \"next_page\": null,\n  \"previous_page\": null,\n  \"count\": 1,\n  \"meta\": {\n    \"has_more\": false\n  }\n}\n",
+					r.Envelope, r.TestID(),
+				)
+			} else {
+				content = fmt.Sprintf("{\n  %q: {\n    \"id\": %s\n  }\n}\n", r.ItemEnvelope, r.TestID())
+			}
+			result[filepath.Join("fixture", methods[verb], name)] = []byte(content)
+		}
+	}
+	return result
 }
 
-var funcData []FuncTemplateData = []FuncTemplateData{
-	{
-		FuncName:    "Automations",
-		ObjectName:  "Automation",
-		ApiEndpoint: "/automation.json",
-		JsonName:    "automations",
-		FileName:    "automation",
-	},
-	{
-		FuncName:    "GroupMemberships",
-		ObjectName:  "GroupMembership",
-		ApiEndpoint: "/group_memberships.json",
-		JsonName:    "group_memberships",
-		FileName:    "group_membership",
-	},
-	{
-		FuncName:    "Macros",
-		ObjectName:  "Macro",
-		ApiEndpoint: "/macros.json",
-		JsonName:    "macros",
-		FileName:    "macro",
-	},
-	{
-		FuncName:    "OrganizationFields",
-		ObjectName:  "OrganizationField",
-		ApiEndpoint: "/organization_fields.json",
-		JsonName:    "organization_fields",
-		FileName:    "organization_field",
-	},
-	{
-		FuncName:    "OrganizationMemberships",
-		ObjectName:  "OrganizationMembership",
-		ApiEndpoint: "/organization_memberships.json",
-		JsonName:    "organization_memberships",
-		FileName:    "organization_membership",
-	},
-	{
-		FuncName:    "Organizations",
-		ObjectName:  "Organization",
-		ApiEndpoint: "/organizations.json",
-		JsonName:    "organizations",
-		FileName:    "organization",
-	},
-	{
-		FuncName:    "Search",
-		ObjectName:  "SearchResults",
-		ApiEndpoint: "/search.json",
-		JsonName:    "results",
-		FileName:    "search",
-	},
-	{
-		FuncName:    "SLAPolicies",
-		ObjectName:  "SLAPolicy",
-		ApiEndpoint: "/slas/policies.json",
-		JsonName:    "sla_policies",
-		FileName:    "sla_policy",
-	},
-	{
-		FuncName:    "AllTicketAudits",
-		ObjectName:  "TicketAudit",
-		ApiEndpoint: "/ticket_audits.json",
-		JsonName:    "audits",
-		FileName:    "all_ticket_audit",
-	},
-	{
-		FuncName:    "TicketAudits",
-		ObjectName:  "TicketAudit",
-		ApiEndpoint: "/tickets/%d/audits.json",
-		JsonName:    "audits",
-		FileName:    "ticket_audit",
-		ExtraParam:  true,
-	},
-	{
-		FuncName:    "TicketFields",
-		ObjectName:  "TicketField",
-		ApiEndpoint: "/ticket_fields.json",
-		JsonName:    "ticket_fields",
-		FileName:    "ticket_field",
-	},
-	{
-		FuncName:    "TicketForms",
-		ObjectName:  "TicketForm",
-		ApiEndpoint: "/ticket_forms.json",
-		JsonName:    "ticket_forms",
-		FileName:    "ticket_form",
-	},
-	{
-		FuncName:    "Triggers",
-		ObjectName:  "Trigger",
-		ApiEndpoint: "/triggers.json",
-		JsonName:    "triggers",
-		FileName:    "trigger",
-	},
-	{
-		FuncName:    "UserFields",
-		ObjectName:  "UserField",
-		ApiEndpoint: "/user_fields.json",
-		JsonName:    "user_fields",
-		FileName:    "user_field",
-	},
-	{
-		FuncName:    "Users",
-		ObjectName:  "User",
-		ApiEndpoint: "/users.json",
-		JsonName:    "users",
-		FileName:    "user",
-	},
-	{
-		FuncName:    "OrganizationUsers",
-		ObjectName:  "User",
-		ApiEndpoint: "/organizations/%d/users.json",
-		JsonName:    "users",
-		FileName:    "organization_users",
-		ExtraParam:  true,
-	},
-	{
-		FuncName:    "Views",
-		ObjectName:  "View",
-		ApiEndpoint: "/views.json",
-		JsonName:    "views",
-		FileName:    "view",
-	},
-	{
-		FuncName:    "TicketsFromView",
-		ObjectName:  "Ticket",
-		ApiEndpoint: "/views/%d/tickets.json",
-		JsonName:    "tickets",
-		FileName:    "tickets_from_view",
-		ExtraParam:  true,
-	},
-	{
-		FuncName:    "Tickets",
-		ObjectName:  "Ticket",
-		ApiEndpoint: "/tickets.json",
-		JsonName:    "tickets",
-		FileName:    "ticket",
-	},
-	{
-		FuncName:    "TicketComments",
-		ObjectName:  "TicketComment",
-		ApiEndpoint: "/tickets/%d/comments.json",
-		JsonName:    "comments",
-		FileName:    "ticket_comment",
-		ExtraParam:  true,
-	},
-	{
-		FuncName:    "Groups",
-		ObjectName:  "Group",
-		ApiEndpoint: "/groups.json",
-		JsonName:    "groups",
-		FileName:    "group",
-	},
-	{
-		FuncName:    "OrganizationTickets",
-		ObjectName:  "Ticket",
-		ApiEndpoint: "/organizations/%d/tickets.json",
-		JsonName:    "tickets",
-		FileName:    "organization_tickets",
-		ExtraParam:  true,
-	},
+func staleFiles(dir string, files map[string][]byte, fixtures map[string][]byte) ([]string, error) {
+	var stale []string
+	for path, want := range files {
+		got, err := os.ReadFile(filepath.Join(dir, path))
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return nil, err
+		}
+		if !bytes.Equal(got, want) {
+			stale = append(stale, path)
+		}
+	}
+	for path := range fixtures {
+		if _, err := os.Stat(filepath.Join(dir, path)); errors.Is(err, os.ErrNotExist) {
+			stale = append(stale, path)
+		}
+	}
+	sort.Strings(stale)
+	return stale, nil
 }
 
-func main() {
-
-	tt := template.Must(template.New("func").Parse(tpl))
-
-	for _, v := range funcData {
-		dest := "zendesk/" + v.FileName + "_generated.go"
-		file, err := os.Create(dest)
-		if err != nil {
-			panic(err)
+func write(dir string, files map[string][]byte, fixtures map[string][]byte) error {
+	for _, path := range sortedKeys(files) {
+		if err := os.WriteFile(filepath.Join(dir, path), files[path], 0o644); err != nil {
+			return err
 		}
-		defer file.Close()
-		err = tt.Execute(file, v)
-		if err != nil {
-			panic(err)
+		fmt.Println("Generated " + path + " successfully")
+	}
+	for _, path := range sortedKeys(fixtures) {
+		b := fixtures[path]
+		full := filepath.Join(dir, path)
+		if _, err := os.Stat(full); !errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err := os.MkdirAll(filepath.Dir(full), 0o755); err != nil {
+			return err
 		}
-		fmt.Println("Generated " + dest + " successfully")
+		if err := os.WriteFile(full, b, 0o644); err != nil {
+			return err
+		}
+		fmt.Println("Created fixture " + path)
+	}
+	return nil
+}
+
+func sortedKeys(m map[string][]byte) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
 	}
+	sort.Strings(keys)
+	return keys
 }
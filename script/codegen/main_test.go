@@ -0,0 +1,133 @@
+package main
+
+import (
+	"testing"
+)
+
+// TestGeneratedFilesUpToDate fails when resources.yaml or the templates changed without regenerating.
+func TestGeneratedFilesUpToDate(t *testing.T) {
+	spec, err := loadSpec("resources.yaml")
+	if err != nil {
+		t.Fatalf("Failed to load spec: %s", err)
+	}
+
+	files, err := generate(spec)
+	if err != nil {
+		t.Fatalf("Failed to generate: %s", err)
+	}
+
+	stale, err := staleFiles("../..", files, fixtures(spec))
+	if err != nil {
+		t.Fatalf("Failed to compare generated files: %s", err)
+	}
+	if len(stale) > 0 {
+		t.Fatalf("Generated files are stale, run `go run ./script/codegen`: %v", stale)
+	}
+}
+
+func TestLoadSpecRejectsItemVerbsOnNestedResource(t *testing.T) {
+	r := Resource{
+		Name:     "OrganizationTickets",
+		Model:    "Ticket",
+		File:     "organization_tickets",
+		Path:     "/organizations/%d/tickets.json",
+		Envelope: "tickets",
+		IDType:   "int64",
+		Verbs:    []string{VerbList, VerbDelete},
+	}
+	if err := r.validate(); err == nil {
+		t.Fatal("Expected an error for delete on a nested resource")
+	}
+}
+
+func TestOBPOnlyValidatesPagination(t *testing.T) {
+	r := Resource{
+		Name:        "Search",
+		Model:       "SearchResults",
+		File:        "search",
+		Path:        "/search.json",
+		Envelope:    "results",
+		IDType:      "int64",
+		Verbs:       []string{VerbList},
+		OBPOnly:     true,
+		ListOptions: []ListOption{{Name: "Query", Param: "query", Type: "string"}},
+	}
+	if err := r.validate(); err != nil {
+		t.Fatalf("Failed to validate resource: %s", err)
+	}
+	if v := r.Validations(); len(v) == 0 || v[0] != "o.requireOBP()" {
+		t.Fatalf("Expected the iterator options to require OBP, got %v", v)
+	}
+}
+
+func TestIterateGeneratesIteratorOptions(t *testing.T) {
+	r := Resource{
+		Name: "TicketFields", Model: "TicketField", File: "ticket_field", Path: "/ticket_fields.json",
+		Envelope: "ticket_fields", IDType: "int64", Verbs: []string{VerbList}, Iterate: true,
+	}
+	if err := r.validate(); err != nil || !r.HasIteratorOptions() {
+		t.Fatalf("Expected iterator options, got %v", err)
+	}
+
+	r.Path, r.Envelope = "/tickets/%d/audits.json", "audits"
+	if err := r.validate(); err == nil {
+		t.Fatal("Expected an error for a nested resource without parent")
+	}
+}
+
+func TestIteratorOptionsName(t *testing.T) {
+	r := Resource{Name: "Problems", Model: "Ticket", Iterate: true}
+	if r.IteratorOptions() != "TicketIteratorOptions" {
+		t.Fatalf("Unexpected default iterator options %s", r.IteratorOptions())
+	}
+	r.IteratorOptionsName = "ProblemIteratorOptions"
+	if got := r.TestListOptions(); got != "ProblemIteratorOptions{}" {
+		t.Fatalf("Unexpected test list options %s", got)
+	}
+}
+
+func TestSortOptionsValidateSortMode(t *testing.T) {
+	r := Resource{
+		Name:  "DeletedTickets",
+		Model: "DeletedTicket",
+		ListOptions: []ListOption{
+			{Name: "SortBy", Param: "sort_by", Type: "string"},
+			{Name: "SortOrder", Param: "sort_order", Type: "string"},
+		},
+	}
+	v := r.Validations()
+	if len(v) != 1 || v[0] != `o.validateSortMode("", o.SortBy, o.SortOrder)` {
+		t.Fatalf("Expected the sort options to be checked against the pagination, got %v", v)
+	}
+}
+
+func TestGeneratedTestRequests(t *testing.T) {
+	flat := Resource{Name: "Triggers", Model: "Trigger", Path: "/triggers.json"}
+	if flat.TestPath() != "/triggers.json" || flat.TestListOptions() != "noListOptions{}" {
+		t.Fatalf("Unexpected test request %s %s", flat.TestPath(), flat.TestListOptions())
+	}
+
+	nested := Resource{
+		Name: "OrganizationTickets", Model: "Ticket", Path: "/organizations/%d/tickets.json", Parent: "OrganizationID",
+		Sideloads: []string{"users"},
+	}
+	if nested.TestPath() != "/organizations/1/tickets.json" {
+		t.Fatalf("Unexpected test path %s", nested.TestPath())
+	}
+	if got := nested.TestListOptions(); got != "TicketIteratorOptions{OrganizationID: 1}" {
+		t.Fatalf("Unexpected test list options %s", got)
+	}
+}
+
+func TestToSnake(t *testing.T) {
+	tests := map[string]string{
+		"OrganizationID": "organization_id",
+		"TicketID":       "ticket_id",
+		"ID":             "id",
+	}
+	for in, want := range tests {
+		if got := toSnake(in); got != want {
+			t.Errorf("toSnake(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
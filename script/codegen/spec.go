@@ -0,0 +1,314 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Verbs supported in a resource spec
+const (
+	VerbList   = "list"
+	VerbShow   = "show"
+	VerbCreate = "create"
+	VerbUpdate = "update"
+	VerbDelete = "delete"
+)
+
+// Spec is the root of resources.yaml
+type Spec struct {
+	Resources []Resource `yaml:"resources"`
+}
+
+// Resource describes one API resource and the code generated for it.
+type Resource struct {
+	// Name is the stem of the list methods, e.g. "Groups" for GetGroupsIterator
+	Name string `yaml:"name"`
+	// Model is the Go type of a single item, e.g. "Group"
+	Model string `yaml:"model"`
+	// File is the base name of the generated file, e.g. "group" for zendesk/group_generated.go
+	File string `yaml:"file"`
+	// Ref is the API reference URL used in doc comments
+	Ref string `yaml:"ref"`
+
+	// Path is the list endpoint. A %d verb is filled with the parent id for nested resources.
+	Path string `yaml:"path"`
+	// Envelope is the JSON key holding the list of items
+	Envelope string `yaml:"envelope"`
+
+	// ItemPath is the endpoint of a single item, with a verb for its id
+	ItemPath string `yaml:"item_path"`
+	// ItemEnvelope is the JSON key holding a single item
+	ItemEnvelope string `yaml:"item_envelope"`
+	// IDType is the Go type of the item id, "int64" (default) or "string"
+	IDType string `yaml:"id_type"`
+
+	Verbs []string `yaml:"verbs"`
+
+	// Parent is the field of the iterator options holding the parent id of a nested resource
+	Parent string `yaml:"parent"`
+	// ListOptions are the endpoint specific query parameters of the list endpoint
+	ListOptions []ListOption `yaml:"list_options"`
+	// Sideloads are the values accepted by the include parameter of the list endpoint
+	Sideloads []string `yaml:"sideloads"`
+	// OBPOnly is set for list endpoints which do not support cursor based pagination
+	OBPOnly bool `yaml:"obp_only"`
+	// Iterate generates the iterator options and Iterate method of list endpoints without list options
+	// or side-loads
+	Iterate bool `yaml:"iterate"`
+	// IteratorOptionsName is the name of the iterator options struct, <Model>IteratorOptions by default.
+	// It must be set when several resources with iterator options share a model.
+	IteratorOptionsName string `yaml:"iterator_options"`
+
+	// Fixtures are the files under fixture/<METHOD>/ used by the generated tests. A verb
+	// without a fixture is not tested.
+	Fixtures map[string]string `yaml:"fixtures"`
+}
+
+// ListOption is one query parameter of a list endpoint
+type ListOption struct {
+	Name    string   `yaml:"name"`
+	Param   string   `yaml:"param"`
+	Type    string   `yaml:"type"`
+	Doc     string   `yaml:"doc"`
+	Allowed []string `yaml:"allowed"`
+}
+
+var optionTypes = map[string]bool{
+	"string": true, "bool": true, "*bool": true, "int64": true, "[]string": true,
+}
+
+func loadSpec(path string) (*Spec, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var spec Spec
+	if err := yaml.Unmarshal(b, &spec); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	files, options := map[string]bool{}, map[string]bool{}
+	for i := range spec.Resources {
+		r := &spec.Resources[i]
+		if r.IDType == "" {
+			r.IDType = "int64"
+		}
+		if err := r.validate(); err != nil {
+			return nil, fmt.Errorf("%s: resource %q: %w", path, r.Name, err)
+		}
+		if files[r.File] {
+			return nil, fmt.Errorf("%s: resource %q: duplicate file %q", path, r.Name, r.File)
+		}
+		files[r.File] = true
+		if r.HasIteratorOptions() {
+			if options[r.IteratorOptions()] {
+				return nil, fmt.Errorf("%s: resource %q: duplicate iterator options %q, set iterator_options",
+					path, r.Name, r.IteratorOptions())
+			}
+			options[r.IteratorOptions()] = true
+		}
+	}
+	return &spec, nil
+}
+
+func (r *Resource) validate() error {
+	if r.Name == "" || r.Model == "" || r.File == "" {
+		return fmt.Errorf("name, model and file are required")
+	}
+	if r.IDType != "int64" && r.IDType != "string" {
+		return fmt.Errorf("unsupported id_type %q", r.IDType)
+	}
+	for _, v := range r.Verbs {
+		switch v {
+		case VerbList:
+			if r.Path == "" || r.Envelope == "" {
+				return fmt.Errorf("list requires path and envelope")
+			}
+		case VerbShow, VerbCreate, VerbUpdate, VerbDelete:
+			if r.Nested() {
+				return fmt.Errorf("%s is not supported on nested resources", v)
+			}
+			if v != VerbCreate && r.ItemPath == "" {
+				return fmt.Errorf("%s requires item_path", v)
+			}
+			if v != VerbDelete && r.ItemEnvelope == "" {
+				return fmt.Errorf("%s requires item_envelope", v)
+			}
+		default:
+			return fmt.Errorf("unknown verb %q", v)
+		}
+	}
+	if r.OBPOnly && !r.Has(VerbList) {
+		return fmt.Errorf("obp_only requires the list verb")
+	}
+	if r.HasIteratorOptions() {
+		if !r.Has(VerbList) {
+			return fmt.Errorf("list_options, sideloads and iterate require the list verb")
+		}
+		if r.Nested() && r.Parent == "" {
+			return fmt.Errorf("nested resources with list_options, sideloads or iterate require parent")
+		}
+	}
+	for _, o := range r.ListOptions {
+		if o.Name == "" || o.Param == "" {
+			return fmt.Errorf("list option requires name and param")
+		}
+		if !optionTypes[o.Type] {
+			return fmt.Errorf("list option %s has unsupported type %q", o.Name, o.Type)
+		}
+		if len(o.Allowed) > 0 && o.Type != "string" {
+			return fmt.Errorf("list option %s: allowed is only supported on string options", o.Name)
+		}
+	}
+	for verb := range r.Fixtures {
+		if !r.Has(verb) {
+			return fmt.Errorf("fixture for unsupported verb %q", verb)
+		}
+	}
+	return nil
+}
+
+// Has reports whether the resource supports verb
+func (r Resource) Has(verb string) bool {
+	for _, v := range r.Verbs {
+		if v == verb {
+			return true
+		}
+	}
+	return false
+}
+
+// Nested reports whether the list path takes a parent id
+func (r Resource) Nested() bool {
+	return strings.Contains(r.Path, "%")
+}
+
+// HasIteratorOptions reports whether an endpoint specific iterator options struct is generated
+func (r Resource) HasIteratorOptions() bool {
+	return len(r.ListOptions) > 0 || len(r.Sideloads) > 0 || r.Iterate
+}
+
+// IteratorOptions is the name of the endpoint specific iterator options struct
+func (r Resource) IteratorOptions() string {
+	if r.IteratorOptionsName != "" {
+		return r.IteratorOptionsName
+	}
+	return r.Model + "IteratorOptions"
+}
+
+// TestID is the id literal passed by the generated tests
+func (r Resource) TestID() string {
+	if r.IDType == "string" {
+		return `"1"`
+	}
+	return "1"
+}
+
+// Validations are the Go expressions checking the iterator options
+func (r Resource) Validations() []string {
+	var v []string
+	if r.OBPOnly {
+		v = append(v, "o.requireOBP()")
+	}
+	if r.Nested() {
+		v = append(v, fmt.Sprintf("requireID(%q, o.%s)", toSnake(r.Parent), r.Parent))
+	}
+	sort := map[string]string{"sort": `""`, "sort_by": `""`, "sort_order": `""`}
+	sorted := false
+	for _, o := range r.ListOptions {
+		if len(o.Allowed) > 0 {
+			v = append(v, fmt.Sprintf("validateOption(%q, o.%s, %s)", o.Param, o.Name, quoteAll(o.Allowed)))
+		}
+		if _, ok := sort[o.Param]; ok {
+			sort[o.Param] = "o." + o.Name
+			sorted = true
+		}
+	}
+	if sorted {
+		v = append(v, fmt.Sprintf("o.validateSortMode(%s, %s, %s)", sort["sort"], sort["sort_by"], sort["sort_order"]))
+	}
+	if len(r.Sideloads) > 0 {
+		v = append(v, fmt.Sprintf("validateInclude(o.Include, %s)", quoteAll(r.Sideloads)))
+	}
+	return v
+}
+
+// Imports are the packages used by the generated resource file
+func (r Resource) Imports() []string {
+	imports := []string{"context"}
+	if r.Has(VerbShow) || r.Has(VerbCreate) || r.Has(VerbUpdate) {
+		imports = append(imports, "encoding/json")
+	}
+	if r.HasIteratorOptions() && len(r.Validations()) > 1 {
+		imports = append(imports, "errors")
+	}
+	if r.Nested() || r.Has(VerbShow) || r.Has(VerbUpdate) || r.Has(VerbDelete) {
+		imports = append(imports, "fmt")
+	}
+	if r.Has(VerbList) {
+		imports = append(imports, "github.com/JacobPotter/go-zendesk/client")
+	}
+	return imports
+}
+
+func quoteAll(values []string) string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = fmt.Sprintf("%q", v)
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// toSnake converts a Go field name such as OrganizationID to organization_id
+func toSnake(name string) string {
+	var b strings.Builder
+	runes := []rune(name)
+	for i, c := range runes {
+		upper := c >= 'A' && c <= 'Z'
+		if upper && i > 0 {
+			prevLower := runes[i-1] >= 'a' && runes[i-1] <= 'z'
+			nextLower := i+1 < len(runes) && runes[i+1] >= 'a' && runes[i+1] <= 'z'
+			if prevLower || nextLower {
+				b.WriteByte('_')
+			}
+		}
+		b.WriteString(strings.ToLower(string(c)))
+	}
+	return b.String()
+}
+
+// TestPath is the list path requested by the generated tests, which pass 1 as the parent id
+func (r Resource) TestPath() string {
+	if r.Nested() {
+		return fmt.Sprintf(r.Path, 1)
+	}
+	return r.Path
+}
+
+// TestListOptions is the list options literal passed by the generated tests. Resources without
+// iterator options get noListOptions, so that no deprecated CommonOptions parameter is sent.
+func (r Resource) TestListOptions() string {
+	if !r.HasIteratorOptions() {
+		return "noListOptions{}"
+	}
+	var fields []string
+	if r.OBPOnly {
+		fields = append(fields, "IteratorOptions: IteratorOptions{UseOBP: true}")
+	}
+	if r.Nested() {
+		fields = append(fields, r.Parent+": 1")
+	}
+	return r.IteratorOptions() + "{" + strings.Join(fields, ", ") + "}"
+}
+
+// ZeroID is the zero value literal of the item id
+func (r Resource) ZeroID() string {
+	if r.IDType == "string" {
+		return `""`
+	}
+	return "0"
+}
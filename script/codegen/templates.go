@@ -0,0 +1,478 @@
+package main
+
+const header = `// Code generated by Script. DO NOT EDIT.
+// Source: script/codegen/resources.yaml
+//
+// Generated by this command:
+//
+//	go run ./script/codegen
+`
+
+var resourceTpl = header + `
+package zendesk
+
+import (
+{{- range .Imports }}
+	"{{ . }}"
+{{- end }}
+)
+{{ if .Has "list" }}
+// Get{{.Name}}Iterator returns an Iterator over {{.Path}}
+func (z *Client) Get{{.Name}}Iterator(ctx context.Context, opts *PaginationOptions) *Iterator[{{.Model}}] {
+	return &Iterator[{{.Model}}]{
+		CommonOptions: opts.CommonOptions,
+		pageSize:      opts.PageSize,
+		hasMore:       true,
+		isCBP:         opts.IsCBP,
+		pageAfter:     "",
+		pageIndex:     1,
+		ctx:           ctx,
+		obpFunc:       z.Get{{.Name}}OBP,
+		cbpFunc:       z.Get{{.Name}}CBP,
+	}
+}
+
+// Get{{.Name}}OBP fetches a page of {{.Path}} with offset based pagination
+func (z *Client) Get{{.Name}}OBP(ctx context.Context, opts *OBPOptions) ([]{{.Model}}, Page, error) {
+	var data struct {
+		{{.Name}} []{{.Model}} ` + "`json:\"{{.Envelope}}\"`" + `
+		Page
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &OBPOptions{}
+	}
+{{ if .Nested }}
+	path := fmt.Sprintf("{{.Path}}", tmp.Id)
+	u, err := tmp.addOptions(path)
+{{- else }}
+	u, err := tmp.addOptions("{{.Path}}")
+{{- end }}
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	err = z.getData(ctx, u, &data)
+	if err != nil {
+		return nil, Page{}, err
+	}
+	return data.{{.Name}}, data.Page, nil
+}
+
+{{ if .OBPOnly -}}
+// Get{{.Name}}CBP always returns an *InvalidOptionError: {{.Path}} does not support cursor based pagination
+func (z *Client) Get{{.Name}}CBP(ctx context.Context, opts *CBPOptions) ([]{{.Model}}, client.CursorPaginationMeta, error) {
+	return nil, client.CursorPaginationMeta{}, cbpUnsupported()
+}
+{{- else -}}
+// Get{{.Name}}CBP fetches a page of {{.Path}} with cursor based pagination
+func (z *Client) Get{{.Name}}CBP(ctx context.Context, opts *CBPOptions) ([]{{.Model}}, client.CursorPaginationMeta, error) {
+	var data struct {
+		{{.Name}} []{{.Model}}                    ` + "`json:\"{{.Envelope}}\"`" + `
+		Meta    client.CursorPaginationMeta ` + "`json:\"meta\"`" + `
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &CBPOptions{}
+	}
+{{ if .Nested }}
+	path := fmt.Sprintf("{{.Path}}", tmp.Id)
+	u, err := tmp.addOptions(path)
+{{- else }}
+	u, err := tmp.addOptions("{{.Path}}")
+{{- end }}
+	if err != nil {
+		return nil, data.Meta, err
+	}
+
+	err = z.getData(ctx, u, &data)
+	if err != nil {
+		return nil, data.Meta, err
+	}
+	return data.{{.Name}}, data.Meta, nil
+}
+{{- end }}
+{{ end -}}
+{{ if .HasIteratorOptions }}
+// {{.IteratorOptions}} are the options accepted by Iterate{{.Name}}
+{{- if .Ref }}
+//
+// ref: {{.Ref}}
+{{- end }}
+type {{.IteratorOptions}} struct {
+	IteratorOptions
+{{ if .Nested }}
+	// {{.Parent}} is required
+	{{.Parent}} int64 ` + "`url:\"-\"`" + `
+{{ end -}}
+{{ range .ListOptions }}
+{{- if .Doc }}
+	// {{.Doc}}
+{{- end }}
+	{{.Name}} {{.Type}} ` + "`url:\"{{.Param}},omitempty\"`" + `
+{{- end }}
+{{- if .Sideloads }}
+
+	// Include can take a comma separated list of {{ join .Sideloads }}.
+	// The side-loads of each page are returned by Iterator.Sideloads.
+	Include string ` + "`url:\"include,omitempty\"`" + `
+{{- end }}
+}
+
+// Validate checks the options of {{.IteratorOptions}}
+func (o {{.IteratorOptions}}) Validate() error {
+{{- $v := .Validations }}
+{{- if eq (len $v) 0 }}
+	return nil
+{{- else if eq (len $v) 1 }}
+	return {{ index $v 0 }}
+{{- else }}
+	return errors.Join(
+{{- range $v }}
+		{{ . }},
+{{- end }}
+	)
+{{- end }}
+}
+
+// Iterate{{.Name}} returns an Iterator over {{.Path}}
+{{- if .Ref }}
+//
+// ref: {{.Ref}}
+{{- end }}
+func (z *Client) Iterate{{.Name}}(ctx context.Context, opts *{{.IteratorOptions}}) *Iterator[{{.Model}}] {
+	if opts == nil {
+		opts = &{{.IteratorOptions}}{}
+	}
+{{- if .Sideloads }}
+	return newSideloadIterator[{{.Model}}](ctx, z, opts.IteratorOptions, *opts, {{ if .Nested }}fmt.Sprintf("{{.Path}}", opts.{{.Parent}}){{ else }}"{{.Path}}"{{ end }}, "{{.Envelope}}")
+{{- else }}
+	return newListIterator(ctx, opts.IteratorOptions, *opts, {{ if .Nested }}opts.{{.Parent}}{{ else }}0{{ end }}, z.Get{{.Name}}OBP, z.Get{{.Name}}CBP)
+{{- end }}
+}
+{{ end -}}
+{{ if .Has "show" }}
+// Get{{.Model}} gets the specified {{.Model}}
+{{- if .Ref }}
+//
+// ref: {{.Ref}}
+{{- end }}
+func (z *Client) Get{{.Model}}(ctx context.Context, id {{.IDType}}) ({{.Model}}, error) {
+	var result struct {
+		{{.Model}} {{.Model}} ` + "`json:\"{{.ItemEnvelope}}\"`" + `
+	}
+
+	body, err := z.Get(ctx, fmt.Sprintf("{{.ItemPath}}", id))
+	if err != nil {
+		return {{.Model}}{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return {{.Model}}{}, err
+	}
+	return result.{{.Model}}, nil
+}
+{{ end -}}
+{{ if .Has "create" }}
+// Create{{.Model}} creates a new {{.Model}}
+{{- if .Ref }}
+//
+// ref: {{.Ref}}
+{{- end }}
+func (z *Client) Create{{.Model}}(ctx context.Context, {{ lower .Model }} {{.Model}}) ({{.Model}}, error) {
+	var data, result struct {
+		{{.Model}} {{.Model}} ` + "`json:\"{{.ItemEnvelope}}\"`" + `
+	}
+	data.{{.Model}} = {{ lower .Model }}
+
+	body, err := z.Post(ctx, "{{.Path}}", data)
+	if err != nil {
+		return {{.Model}}{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return {{.Model}}{}, err
+	}
+	return result.{{.Model}}, nil
+}
+{{ end -}}
+{{ if .Has "update" }}
+// Update{{.Model}} updates the specified {{.Model}}
+{{- if .Ref }}
+//
+// ref: {{.Ref}}
+{{- end }}
+func (z *Client) Update{{.Model}}(ctx context.Context, id {{.IDType}}, {{ lower .Model }} {{.Model}}) ({{.Model}}, error) {
+	var data, result struct {
+		{{.Model}} {{.Model}} ` + "`json:\"{{.ItemEnvelope}}\"`" + `
+	}
+	data.{{.Model}} = {{ lower .Model }}
+
+	body, err := z.Put(ctx, fmt.Sprintf("{{.ItemPath}}", id), data)
+	if err != nil {
+		return {{.Model}}{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return {{.Model}}{}, err
+	}
+	return result.{{.Model}}, nil
+}
+{{ end -}}
+{{ if .Has "delete" }}
+// Delete{{.Model}} deletes the specified {{.Model}}
+{{- if .Ref }}
+//
+// ref: {{.Ref}}
+{{- end }}
+func (z *Client) Delete{{.Model}}(ctx context.Context, id {{.IDType}}) error {
+	return z.Delete(ctx, fmt.Sprintf("{{.ItemPath}}", id))
+}
+{{ end -}}
+`
+
+var apiTpl = header + `
+package zendesk
+
+import (
+	"context"
+
+	"github.com/JacobPotter/go-zendesk/client"
+)
+
+// GeneratedAPI an interface containing all the methods generated from script/codegen/resources.yaml
+type GeneratedAPI interface {
+{{- range .Resources }}
+{{- if .Has "list" }}
+	Get{{.Name}}Iterator(ctx context.Context, opts *PaginationOptions) *Iterator[{{.Model}}]
+	Get{{.Name}}OBP(ctx context.Context, opts *OBPOptions) ([]{{.Model}}, Page, error)
+	Get{{.Name}}CBP(ctx context.Context, opts *CBPOptions) ([]{{.Model}}, client.CursorPaginationMeta, error)
+{{- end }}
+{{- if .HasIteratorOptions }}
+	Iterate{{.Name}}(ctx context.Context, opts *{{.IteratorOptions}}) *Iterator[{{.Model}}]
+{{- end }}
+{{- if .Has "show" }}
+	Get{{.Model}}(ctx context.Context, id {{.IDType}}) ({{.Model}}, error)
+{{- end }}
+{{- if .Has "create" }}
+	Create{{.Model}}(ctx context.Context, {{ lower .Model }} {{.Model}}) ({{.Model}}, error)
+{{- end }}
+{{- if .Has "update" }}
+	Update{{.Model}}(ctx context.Context, id {{.IDType}}, {{ lower .Model }} {{.Model}}) ({{.Model}}, error)
+{{- end }}
+{{- if .Has "delete" }}
+	Delete{{.Model}}(ctx context.Context, id {{.IDType}}) error
+{{- end }}
+{{- end }}
+}
+`
+
+var testTpl = header + `
+package zendesk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"testing"
+
+	"github.com/JacobPotter/go-zendesk/client"
+	"github.com/JacobPotter/go-zendesk/testhelper"
+)
+
+// noListOptions sends no endpoint specific parameter, unlike the deprecated CommonOptions
+type noListOptions struct{}
+
+func (noListOptions) Validate() error { return nil }
+
+func TestGeneratedList(t *testing.T) {
+	tests := []struct {
+		name    string
+		fixture string
+		path    string
+		query   url.Values
+		list    func(c *Client) (int, error)
+	}{
+{{- range .Resources }}
+{{- if index .Fixtures "list" }}
+		{
+			name:    "{{.Name}}OBP",
+			fixture: "{{ index .Fixtures "list" }}",
+			path:    "{{.TestPath}}",
+			query:   url.Values{"page": {"2"}, "per_page": {"10"}},
+			list: func(c *Client) (int, error) {
+				items, _, err := c.Get{{.Name}}OBP(ctx, &OBPOptions{
+					PageOptions: PageOptions{PerPage: 10, Page: 2},
+{{- if .Nested }}
+					CommonOptions: CommonOptions{Id: 1},
+{{- end }}
+					ListOptions: {{.TestListOptions}},
+				})
+				return len(items), err
+			},
+		},
+{{- if not .OBPOnly }}
+		{
+			name:    "{{.Name}}CBP",
+			fixture: "{{ index .Fixtures "list" }}",
+			path:    "{{.TestPath}}",
+			query:   url.Values{"page[size]": {"10"}, "page[after]": {"next"}},
+			list: func(c *Client) (int, error) {
+				items, _, err := c.Get{{.Name}}CBP(ctx, &CBPOptions{
+					CursorPagination: client.CursorPagination{PageSize: 10, PageAfter: "next"},
+{{- if .Nested }}
+					CommonOptions: CommonOptions{Id: 1},
+{{- end }}
+					ListOptions: {{.TestListOptions}},
+				})
+				return len(items), err
+			},
+		},
+{{- end }}
+{{- if .HasIteratorOptions }}
+		{
+			name:    "Iterate{{.Name}}",
+			fixture: "{{ index .Fixtures "list" }}",
+			path:    "{{.TestPath}}",
+{{- if .OBPOnly }}
+			query:   url.Values{"page": {"1"}, "per_page": {"100"}},
+{{- else }}
+			query:   url.Values{"page[size]": {"100"}},
+{{- end }}
+			list: func(c *Client) (int, error) {
+				items, err := c.Iterate{{.Name}}(ctx, &{{.TestListOptions}}).GetNext()
+				return len(items), err
+			},
+		},
+{{- end }}
+{{- end }}
+{{- end }}
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != tt.path {
+					t.Errorf("Expected path %s, got %s", tt.path, r.URL.Path)
+				}
+				if got := r.URL.Query().Encode(); got != tt.query.Encode() {
+					t.Errorf("Expected query %s, got %s", tt.query.Encode(), got)
+				}
+				_, _ = w.Write(testhelper.ReadFixture(t, filepath.Join(http.MethodGet, tt.fixture)))
+			}))
+			c := NewTestClient(mockAPI)
+			defer mockAPI.Close()
+
+			count, err := tt.list(c)
+			if err != nil {
+				t.Fatalf("Failed to list: %s", err)
+			}
+			if count == 0 {
+				t.Fatal("Expected at least one item")
+			}
+		})
+	}
+}
+
+func TestGeneratedItem(t *testing.T) {
+	tests := []struct {
+		name    string
+		method  string
+		fixture string
+		item    func(c *Client) (bool, error)
+	}{
+{{- range .Resources }}
+{{- if index .Fixtures "show" }}
+		{
+			name:    "Get{{.Model}}",
+			method:  http.MethodGet,
+			fixture: "{{ index .Fixtures "show" }}",
+			item: func(c *Client) (bool, error) {
+				item, err := c.Get{{.Model}}(ctx, {{.TestID}})
+				return item.ID != {{ .ZeroID }}, err
+			},
+		},
+{{- end }}
+{{- if index .Fixtures "create" }}
+		{
+			name:    "Create{{.Model}}",
+			method:  http.MethodPost,
+			fixture: "{{ index .Fixtures "create" }}",
+			item: func(c *Client) (bool, error) {
+				item, err := c.Create{{.Model}}(ctx, {{.Model}}{})
+				return item.ID != {{ .ZeroID }}, err
+			},
+		},
+{{- end }}
+{{- if index .Fixtures "update" }}
+		{
+			name:    "Update{{.Model}}",
+			method:  http.MethodPut,
+			fixture: "{{ index .Fixtures "update" }}",
+			item: func(c *Client) (bool, error) {
+				item, err := c.Update{{.Model}}(ctx, {{.TestID}}, {{.Model}}{})
+				return item.ID != {{ .ZeroID }}, err
+			},
+		},
+{{- end }}
+{{- end }}
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockAPI := testhelper.NewMockAPI(t, tt.method, tt.fixture)
+			c := NewTestClient(mockAPI)
+			defer mockAPI.Close()
+
+			hasID, err := tt.item(c)
+			if err != nil {
+				t.Fatalf("Failed to call %s: %s", tt.name, err)
+			}
+			if !hasID {
+				t.Fatal("Expected the returned item to have an id")
+			}
+		})
+	}
+}
+
+func TestGeneratedDelete(t *testing.T) {
+	tests := []struct {
+		name   string
+		delete func(c *Client) error
+	}{
+{{- range .Resources }}
+{{- if .Has "delete" }}
+		{
+			name: "Delete{{.Model}}",
+			delete: func(c *Client) error {
+				return c.Delete{{.Model}}(ctx, {{.TestID}})
+			},
+		},
+{{- end }}
+{{- end }}
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodDelete {
+					t.Errorf("Expected DELETE request, got %s", r.Method)
+				}
+				w.WriteHeader(http.StatusNoContent)
+			}))
+			c := NewTestClient(mockAPI)
+			defer mockAPI.Close()
+
+			if err := tt.delete(c); err != nil {
+				t.Fatalf("Failed to call %s: %s", tt.name, err)
+			}
+		})
+	}
+}
+`
@@ -0,0 +1,212 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// existingField is a field of a hand-written struct, keyed by its JSON name
+type existingField struct {
+	Name string
+	Type string
+}
+
+// parseStructs collects the JSON fields of every struct declared in the non-test Go files of dir.
+// Fields of embedded structs declared in the same package are merged into the embedding struct.
+func parseStructs(dir string) (map[string]map[string]existingField, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+
+	fset := token.NewFileSet()
+	specs := map[string]*ast.StructType{}
+	for _, path := range files {
+		if strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, path, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+		ast.Inspect(f, func(n ast.Node) bool {
+			ts, ok := n.(*ast.TypeSpec)
+			if !ok {
+				return true
+			}
+			if st, ok := ts.Type.(*ast.StructType); ok {
+				specs[ts.Name.Name] = st
+			}
+			return false
+		})
+	}
+
+	structs := map[string]map[string]existingField{}
+	var collect func(name string, st *ast.StructType, fields map[string]existingField, seen map[string]bool)
+	collect = func(name string, st *ast.StructType, fields map[string]existingField, seen map[string]bool) {
+		if seen[name] {
+			return
+		}
+		seen[name] = true
+		for _, f := range st.Fields.List {
+			typ := exprString(f.Type)
+			if len(f.Names) == 0 {
+				if embedded, ok := specs[strings.TrimPrefix(typ, "*")]; ok {
+					collect(strings.TrimPrefix(typ, "*"), embedded, fields, seen)
+				}
+				continue
+			}
+			jsonName := ""
+			if f.Tag != nil {
+				tag := reflect.StructTag(strings.Trim(f.Tag.Value, "`"))
+				jsonName = strings.Split(tag.Get("json"), ",")[0]
+			}
+			if jsonName == "" || jsonName == "-" {
+				continue
+			}
+			fields[jsonName] = existingField{Name: f.Names[0].Name, Type: typ}
+		}
+	}
+	for name, st := range specs {
+		fields := map[string]existingField{}
+		collect(name, st, fields, map[string]bool{})
+		structs[name] = fields
+	}
+	return structs, nil
+}
+
+func exprString(e ast.Expr) string {
+	switch t := e.(type) {
+	case *ast.Ident:
+		return t.Name
+	case *ast.StarExpr:
+		return "*" + exprString(t.X)
+	case *ast.SelectorExpr:
+		return exprString(t.X) + "." + t.Sel.Name
+	case *ast.ArrayType:
+		return "[]" + exprString(t.Elt)
+	case *ast.MapType:
+		return "map[" + exprString(t.Key) + "]" + exprString(t.Value)
+	case *ast.InterfaceType:
+		return "interface{}"
+	case *ast.StructType:
+		return "struct{...}"
+	case *ast.IndexExpr:
+		return exprString(t.X) + "[" + exprString(t.Index) + "]"
+	default:
+		return fmt.Sprintf("%T", e)
+	}
+}
+
+// Difference describes how a hand-written struct differs from the generated model of the same name
+type Difference struct {
+	Model    string
+	Missing  []Field
+	Mismatch []TypeMismatch
+}
+
+// TypeMismatch is a field whose hand-written type differs from the generated one
+type TypeMismatch struct {
+	Field    Field
+	Existing existingField
+}
+
+// diff compares the generated models with the hand-written structs of the same name
+func (g *generator) diff(existing map[string]map[string]existingField) []Difference {
+	var result []Difference
+	for _, name := range sortedKeys(g.models) {
+		m := g.models[name]
+		fields, ok := existing[name]
+		if !ok || m.Schema == "" {
+			continue
+		}
+
+		d := Difference{Model: name}
+		for _, f := range m.Fields {
+			e, ok := fields[f.JSONName]
+			if !ok {
+				d.Missing = append(d.Missing, f)
+				continue
+			}
+			if g.comparable(f.Type) && baseType(e.Type) != g.scalarType(f.Type) {
+				d.Mismatch = append(d.Mismatch, TypeMismatch{Field: f, Existing: e})
+			}
+		}
+		if len(d.Missing) > 0 || len(d.Mismatch) > 0 {
+			result = append(result, d)
+		}
+	}
+	return result
+}
+
+// comparable reports whether a generated type is a builtin (or enum) type worth comparing. Generated
+// nested structs are not compared as the hand-written structs name them differently.
+func (g *generator) comparable(t string) bool {
+	if _, ok := g.enums[baseType(t)]; ok {
+		return true
+	}
+	switch strings.TrimPrefix(baseType(t), "[]") {
+	case "int64", "string", "bool", "float64", "Timestamp":
+		return true
+	}
+	return false
+}
+
+// scalarType returns the comparable form of a generated type: enums are compared as strings
+func (g *generator) scalarType(t string) string {
+	if _, ok := g.enums[baseType(t)]; ok {
+		return "string"
+	}
+	return baseType(t)
+}
+
+// baseType strips the pointer so that nullability alone is not reported as a mismatch
+func baseType(t string) string {
+	return strings.TrimPrefix(t, "*")
+}
+
+func writeReport(w io.Writer, diffs []Difference) error {
+	if len(diffs) == 0 {
+		_, err := fmt.Fprintln(w, "All models match the OpenAPI specification.")
+		return err
+	}
+
+	for _, d := range diffs {
+		if _, err := fmt.Fprintf(w, "%s\n", d.Model); err != nil {
+			return err
+		}
+		sort.Slice(d.Missing, func(i, j int) bool { return d.Missing[i].JSONName < d.Missing[j].JSONName })
+		for _, f := range d.Missing {
+			if _, err := fmt.Fprintf(w, "  missing   %s %s `json:\"%s\"`\n", f.Name, f.Type, f.JSONName); err != nil {
+				return err
+			}
+		}
+		for _, m := range d.Mismatch {
+			if _, err := fmt.Fprintf(w, "  mismatch  %s: %s, spec has %s (%s)\n",
+				m.Existing.Name, m.Existing.Type, m.Field.Type, m.Field.JSONName); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func writeReportFile(path string, diffs []Difference) error {
+	if path == "" || path == "-" {
+		return writeReport(os.Stdout, diffs)
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return writeReport(f, diffs)
+}
@@ -0,0 +1,305 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// timestampImport is the package of the Timestamp type of the date-time properties
+const timestampImport = "github.com/JacobPotter/go-zendesk/zendesk"
+
+// initialisms are the snake case words written in upper case in Go identifiers
+var initialisms = map[string]string{
+	"id": "ID", "ids": "IDs", "url": "URL", "urls": "URLs", "api": "API", "html": "HTML",
+	"sla": "SLA", "cc": "CC", "ccs": "CCs", "ip": "IP", "uuid": "UUID", "json": "JSON", "csat": "CSAT",
+}
+
+// Model is a Go struct generated from a schema
+type Model struct {
+	Name   string
+	Schema string
+	Doc    string
+	Fields []Field
+}
+
+// Field is one property of a Model
+type Field struct {
+	Name     string
+	JSONName string
+	Type     string
+	Doc      string
+	ReadOnly bool
+}
+
+// Enum is a Go string type with a constant per enum value
+type Enum struct {
+	Name   string
+	Values []string
+}
+
+// generator converts the schemas of a Document into Models and Enums
+type generator struct {
+	doc    *Document
+	names  map[string]string
+	models map[string]*Model
+	enums  map[string]*Enum
+}
+
+func newGenerator(doc *Document) *generator {
+	g := &generator{
+		doc:    doc,
+		names:  map[string]string{},
+		models: map[string]*Model{},
+		enums:  map[string]*Enum{},
+	}
+
+	// Zendesk suffixes most schemas with "Object"; drop it unless another schema already has that name.
+	for name := range doc.Components.Schemas {
+		goName := exported(strings.TrimSuffix(name, "Object"))
+		if _, clash := doc.Components.Schemas[goName]; clash && goName != name {
+			goName = exported(name)
+		}
+		g.names[name] = goName
+	}
+	return g
+}
+
+// run builds a Model for every object schema
+func (g *generator) run() {
+	for _, name := range sortedSchemaNames(g.doc) {
+		s := g.doc.resolve(g.doc.Components.Schemas[name])
+		if s == nil || (s.Type != "object" && len(s.Properties) == 0) || len(s.Properties) == 0 {
+			continue
+		}
+		g.model(g.names[name], name, s)
+	}
+}
+
+func (g *generator) model(goName string, schemaName string, s *Schema) {
+	if _, ok := g.models[goName]; ok {
+		return
+	}
+
+	m := &Model{Name: goName, Schema: schemaName, Doc: firstLine(s.Description)}
+	g.models[goName] = m
+
+	props := make([]string, 0, len(s.Properties))
+	for p := range s.Properties {
+		props = append(props, p)
+	}
+	sort.Strings(props)
+
+	for _, p := range props {
+		prop := s.Properties[p]
+		fieldName := camel(p)
+		m.Fields = append(m.Fields, Field{
+			Name:     fieldName,
+			JSONName: p,
+			Type:     g.goType(goName+fieldName, p, prop),
+			Doc:      firstLine(g.doc.resolve(prop).Description),
+			ReadOnly: prop.ReadOnly,
+		})
+	}
+}
+
+// goType returns the Go type of a property. ids are always int64, date-time values are *zendesk.Timestamp
+// and nullable scalars are pointers so that null can be told apart from the zero value.
+func (g *generator) goType(typeName string, jsonName string, s *Schema) string {
+	if s.Ref != "" {
+		ref := refName(s.Ref)
+		resolved := g.doc.resolve(s)
+		if resolved != nil && len(resolved.Properties) > 0 {
+			return g.names[ref]
+		}
+		return g.goType(typeName, jsonName, resolved)
+	}
+	if len(s.AllOf) > 0 {
+		if len(s.AllOf) == 1 && s.AllOf[0].Ref != "" {
+			return g.goType(typeName, jsonName, s.AllOf[0])
+		}
+		g.model(typeName, "", g.doc.resolve(s))
+		return typeName
+	}
+
+	var t string
+	switch s.Type {
+	case "string":
+		switch {
+		case s.Format == "date-time":
+			return "*Timestamp"
+		case len(s.Enum) > 0:
+			t = g.enum(typeName, s.Enum)
+		default:
+			t = "string"
+		}
+	case "integer":
+		t = "int64"
+	case "number":
+		t = "float64"
+	case "boolean":
+		t = "bool"
+	case "array":
+		if s.Items == nil {
+			return "[]interface{}"
+		}
+		return "[]" + strings.TrimPrefix(g.goType(typeName+"Item", jsonName, s.Items), "*")
+	case "object":
+		if len(s.Properties) > 0 {
+			g.model(typeName, "", s)
+			return typeName
+		}
+		return "map[string]interface{}"
+	default:
+		return "interface{}"
+	}
+
+	if s.Nullable {
+		return "*" + t
+	}
+	return t
+}
+
+func (g *generator) enum(name string, values []interface{}) string {
+	e := &Enum{Name: name}
+	for _, v := range values {
+		if s, ok := v.(string); ok {
+			e.Values = append(e.Values, s)
+		}
+	}
+	if len(e.Values) == 0 {
+		return "string"
+	}
+	g.enums[name] = e
+	return name
+}
+
+// source renders the generated models and enums as a gofmt'ed Go file
+func (g *generator) source(pkg string) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(header)
+	fmt.Fprintf(&buf, "\npackage %s\n\n", pkg)
+	if g.usesTimestamp() {
+		fmt.Fprintf(&buf, "import %q\n\n", timestampImport)
+	}
+
+	for _, name := range sortedKeys(g.enums) {
+		e := g.enums[name]
+		fmt.Fprintf(&buf, "// %s is an enum generated from the OpenAPI specification\ntype %s string\n\n", e.Name, e.Name)
+		buf.WriteString("const (\n")
+		for _, v := range e.Values {
+			fmt.Fprintf(&buf, "\t%s%s %s = %q\n", e.Name, camel(v), e.Name, v)
+		}
+		buf.WriteString(")\n\n")
+	}
+
+	for _, name := range sortedKeys(g.models) {
+		m := g.models[name]
+		fmt.Fprintf(&buf, "// %s is generated from the OpenAPI specification\n", m.Name)
+		if m.Doc != "" {
+			fmt.Fprintf(&buf, "// %s\n", m.Doc)
+		}
+		if m.Schema != "" {
+			fmt.Fprintf(&buf, "//\n// schema: %s\n", m.Schema)
+		}
+		fmt.Fprintf(&buf, "type %s struct {\n", m.Name)
+		for _, f := range m.Fields {
+			doc := f.Doc
+			if f.ReadOnly {
+				doc = strings.TrimSpace(doc + " (read-only)")
+			}
+			if doc != "" {
+				fmt.Fprintf(&buf, "\t// %s\n", doc)
+			}
+			fmt.Fprintf(&buf, "\t%s %s `json:\"%s,omitempty\"`\n", f.Name, sourceType(f.Type), f.JSONName)
+		}
+		buf.WriteString("}\n\n")
+	}
+
+	b, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("%w\n%s", err, buf.String())
+	}
+	return b, nil
+}
+
+// usesTimestamp reports whether a model has a date-time field
+func (g *generator) usesTimestamp() bool {
+	for _, m := range g.models {
+		for _, f := range m.Fields {
+			if sourceType(f.Type) != f.Type {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// sourceType qualifies Timestamp, which is defined by the zendesk package, in a field type
+func sourceType(t string) string {
+	base := strings.TrimLeft(t, "[]*")
+	if base != "Timestamp" {
+		return t
+	}
+	return strings.TrimSuffix(t, base) + "zendesk." + base
+}
+
+// camel converts snake_case (or any non alphanumeric separated words) to an exported Go identifier
+func camel(s string) string {
+	words := strings.FieldsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	var b strings.Builder
+	for _, w := range words {
+		if i, ok := initialisms[strings.ToLower(w)]; ok {
+			b.WriteString(i)
+			continue
+		}
+		b.WriteString(exported(w))
+	}
+
+	name := b.String()
+	if name == "" || unicode.IsDigit([]rune(name)[0]) {
+		name = "V" + name
+	}
+	return name
+}
+
+func exported(s string) string {
+	if s == "" {
+		return s
+	}
+	r := []rune(s)
+	r[0] = unicode.ToUpper(r[0])
+	return string(r)
+}
+
+func firstLine(s string) string {
+	s = strings.TrimSpace(s)
+	if i := strings.IndexByte(s, '\n'); i >= 0 {
+		s = s[:i]
+	}
+	return strings.TrimSpace(s)
+}
+
+func sortedSchemaNames(doc *Document) []string {
+	names := make([]string, 0, len(doc.Components.Schemas))
+	for name := range doc.Components.Schemas {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
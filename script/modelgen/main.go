@@ -0,0 +1,75 @@
+// Command modelgen generates Go models and enums from Zendesk's OpenAPI specification and reports
+// the fields present in the specification but missing from the hand-written zendesk structs.
+//
+// The specification is vendored in script/modelgen/oas.yaml, an excerpt with the schemas of the core
+// ticketing models. To replace it with the full, up to date specification:
+//
+//	curl -o script/modelgen/oas.yaml https://developer.zendesk.com/zendesk/oas.yaml
+//
+// Then run it from the repository root:
+//
+//	go run ./script/modelgen                    // write zendesk/oasmodel and print the diff report
+//	go run ./script/modelgen -report diff.txt   // write the diff report to a file
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+const header = `// Code generated by Script. DO NOT EDIT.
+// Source: script/modelgen/oas.yaml
+//
+// Generated by this command:
+//
+//	go run ./script/modelgen
+`
+
+func main() {
+	specPath := flag.String("spec", "script/modelgen/oas.yaml", "vendored OpenAPI specification")
+	out := flag.String("out", "zendesk/oasmodel", "output directory of the generated models")
+	pkg := flag.String("pkg", "oasmodel", "package name of the generated models")
+	existing := flag.String("existing", "zendesk", "package directory of the hand-written structs to diff against")
+	report := flag.String("report", "-", "diff report destination, - for stdout")
+	flag.Parse()
+
+	if err := run(*specPath, *out, *pkg, *existing, *report); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(specPath, out, pkg, existingDir, report string) error {
+	doc, err := loadDocument(specPath)
+	if os.IsNotExist(err) {
+		return fmt.Errorf("%w\nvendor the specification with: curl -o %s https://developer.zendesk.com/zendesk/oas.yaml",
+			err, specPath)
+	}
+	if err != nil {
+		return err
+	}
+
+	g := newGenerator(doc)
+	g.run()
+
+	src, err := g.source(pkg)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(out, 0o755); err != nil {
+		return err
+	}
+	dest := filepath.Join(out, "models_generated.go")
+	if err := os.WriteFile(dest, src, 0o644); err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "Generated %s successfully\n", dest)
+
+	structs, err := parseStructs(existingDir)
+	if err != nil {
+		return err
+	}
+	return writeReportFile(report, g.diff(structs))
+}
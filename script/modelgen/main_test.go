@@ -0,0 +1,171 @@
+package main
+
+import (
+	"bytes"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func generateFixture(t *testing.T) *generator {
+	t.Helper()
+	doc, err := loadDocument(filepath.Join("testdata", "oas.yaml"))
+	if err != nil {
+		t.Fatalf("Failed to load spec: %s", err)
+	}
+	g := newGenerator(doc)
+	g.run()
+	return g
+}
+
+func fieldType(t *testing.T, g *generator, model string, jsonName string) string {
+	t.Helper()
+	m, ok := g.models[model]
+	if !ok {
+		t.Fatalf("Model %s was not generated", model)
+	}
+	for _, f := range m.Fields {
+		if f.JSONName == jsonName {
+			return f.Type
+		}
+	}
+	t.Fatalf("Model %s has no field %s", model, jsonName)
+	return ""
+}
+
+func TestGeneratorTypes(t *testing.T) {
+	g := generateFixture(t)
+
+	tests := []struct {
+		model    string
+		field    string
+		expected string
+	}{
+		{"Ticket", "id", "int64"},
+		{"Ticket", "group_id", "int64"},
+		{"Ticket", "assignee_id", "*int64"},
+		{"Ticket", "due_at", "*Timestamp"},
+		{"Ticket", "created_at", "*Timestamp"},
+		{"Ticket", "status", "TicketStatus"},
+		{"Ticket", "email_cc_ids", "[]int64"},
+		{"Ticket", "custom_fields", "[]CustomField"},
+		{"CustomField", "value", "interface{}"},
+		{"View", "created_at", "*Timestamp"},
+		{"View", "default", "bool"},
+		{"TicketMetric", "reply_time_in_minutes", "TicketMetricReplyTimeInMinutes"},
+	}
+	for _, tt := range tests {
+		if got := fieldType(t, g, tt.model, tt.field); got != tt.expected {
+			t.Errorf("%s.%s: expected type %s, got %s", tt.model, tt.field, tt.expected, got)
+		}
+	}
+
+	if e, ok := g.enums["TicketStatus"]; !ok || len(e.Values) != 6 {
+		t.Fatalf("Expected TicketStatus enum with 6 values, got %v", e)
+	}
+}
+
+func TestGeneratorSource(t *testing.T) {
+	g := generateFixture(t)
+
+	src, err := g.source("oasmodel")
+	if err != nil {
+		t.Fatalf("Failed to render source: %s", err)
+	}
+
+	normalized := strings.Join(strings.Fields(string(src)), " ")
+	for _, want := range []string{
+		`TicketStatusOpen TicketStatus = "open"`,
+		"DueAt *zendesk.Timestamp `json:\"due_at,omitempty\"`",
+		"EmailCCIDs []int64 `json:\"email_cc_ids,omitempty\"`",
+		"// schema: TicketObject",
+	} {
+		if !strings.Contains(normalized, want) {
+			t.Errorf("Expected generated source to contain %q", want)
+		}
+	}
+}
+
+// Test that the generated source compiles, including the types it refers to in other packages
+func TestGeneratedSourceTypeChecks(t *testing.T) {
+	g := generateFixture(t)
+
+	src, err := g.source("oasmodel")
+	if err != nil {
+		t.Fatalf("Failed to render source: %s", err)
+	}
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "models_generated.go", src, 0)
+	if err != nil {
+		t.Fatalf("Failed to parse generated source: %s", err)
+	}
+
+	conf := types.Config{Importer: importer.ForCompiler(fset, "source", nil)}
+	if _, err := conf.Check("oasmodel", fset, []*ast.File{file}, nil); err != nil {
+		t.Fatalf("Generated source does not type check: %s", err)
+	}
+}
+
+func TestDiffAgainstZendeskPackage(t *testing.T) {
+	g := generateFixture(t)
+
+	structs, err := parseStructs(filepath.Join("..", "..", "zendesk"))
+	if err != nil {
+		t.Fatalf("Failed to parse zendesk package: %s", err)
+	}
+
+	var buf bytes.Buffer
+	if err := writeReport(&buf, g.diff(structs)); err != nil {
+		t.Fatalf("Failed to write report: %s", err)
+	}
+	report := buf.String()
+
+	for _, want := range []string{
+		"missing   FromMessagingChannel bool `json:\"from_messaging_channel\"`",
+		"mismatch  GroupID: json.Number, spec has int64 (group_id)",
+		"mismatch  ID: int, spec has int64 (id)",
+	} {
+		if !strings.Contains(report, want) {
+			t.Errorf("Expected report to contain %q, got:\n%s", want, report)
+		}
+	}
+	if strings.Contains(report, "CreatedAt: Timestamp") {
+		t.Errorf("Expected timestamps to match the specification, got:\n%s", report)
+	}
+}
+
+// Test that zendesk/oasmodel is up to date with the vendored specification
+func TestVendoredModelsAreCurrent(t *testing.T) {
+	doc, err := loadDocument("oas.yaml")
+	if err != nil {
+		t.Fatalf("Failed to load vendored spec: %s", err)
+	}
+	g := newGenerator(doc)
+	g.run()
+
+	src, err := g.source("oasmodel")
+	if err != nil {
+		t.Fatalf("Failed to render source: %s", err)
+	}
+	committed, err := os.ReadFile(filepath.Join("..", "..", "zendesk", "oasmodel", "models_generated.go"))
+	if err != nil {
+		t.Fatalf("Failed to read generated models: %s", err)
+	}
+	if !bytes.Equal(src, committed) {
+		t.Fatal("zendesk/oasmodel is out of date, run: go run ./script/modelgen")
+	}
+}
+
+func TestRunMissingSpec(t *testing.T) {
+	err := run(filepath.Join(t.TempDir(), "oas.yaml"), t.TempDir(), "oasmodel", "../../zendesk", os.DevNull)
+	if err == nil || !strings.Contains(err.Error(), "curl -o") {
+		t.Fatalf("Expected vendoring instructions, got %v", err)
+	}
+}
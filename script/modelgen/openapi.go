@@ -0,0 +1,81 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Document is the subset of an OpenAPI 3 document read by modelgen
+type Document struct {
+	Components struct {
+		Schemas map[string]*Schema `yaml:"schemas"`
+	} `yaml:"components"`
+}
+
+// Schema is the subset of an OpenAPI 3 schema object read by modelgen
+type Schema struct {
+	Ref                  string             `yaml:"$ref"`
+	Type                 string             `yaml:"type"`
+	Format               string             `yaml:"format"`
+	Description          string             `yaml:"description"`
+	Nullable             bool               `yaml:"nullable"`
+	ReadOnly             bool               `yaml:"readOnly"`
+	Enum                 []interface{}      `yaml:"enum"`
+	Items                *Schema            `yaml:"items"`
+	Properties           map[string]*Schema `yaml:"properties"`
+	AdditionalProperties interface{}        `yaml:"additionalProperties"`
+	AllOf                []*Schema          `yaml:"allOf"`
+}
+
+func loadDocument(path string) (*Document, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var doc Document
+	if err := yaml.Unmarshal(b, &doc); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	if len(doc.Components.Schemas) == 0 {
+		return nil, fmt.Errorf("%s: no components.schemas found", path)
+	}
+	return &doc, nil
+}
+
+// refName returns the schema name of a local reference such as #/components/schemas/TicketObject
+func refName(ref string) string {
+	return strings.TrimPrefix(ref, "#/components/schemas/")
+}
+
+// resolve follows $ref and merges allOf so that the returned schema carries its own properties
+func (d *Document) resolve(s *Schema) *Schema {
+	if s == nil {
+		return nil
+	}
+	if s.Ref != "" {
+		return d.resolve(d.Components.Schemas[refName(s.Ref)])
+	}
+	if len(s.AllOf) == 0 {
+		return s
+	}
+
+	merged := &Schema{
+		Type:        "object",
+		Description: s.Description,
+		Nullable:    s.Nullable,
+		Properties:  map[string]*Schema{},
+	}
+	for _, part := range s.AllOf {
+		for name, prop := range d.resolve(part).Properties {
+			merged.Properties[name] = prop
+		}
+	}
+	for name, prop := range s.Properties {
+		merged.Properties[name] = prop
+	}
+	return merged
+}
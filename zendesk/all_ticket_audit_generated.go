@@ -1,9 +1,9 @@
 // Code generated by Script. DO NOT EDIT.
-// Source: script/codegen/main.go
+// Source: script/codegen/resources.yaml
 //
 // Generated by this command:
 //
-//	go run script/codegen/main.go
+//	go run ./script/codegen
 
 package zendesk
 
@@ -12,6 +12,7 @@ import (
 	"github.com/JacobPotter/go-zendesk/client"
 )
 
+// GetAllTicketAuditsIterator returns an Iterator over /ticket_audits.json
 func (z *Client) GetAllTicketAuditsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[TicketAudit] {
 	return &Iterator[TicketAudit]{
 		CommonOptions: opts.CommonOptions,
@@ -26,9 +27,10 @@ func (z *Client) GetAllTicketAuditsIterator(ctx context.Context, opts *Paginatio
 	}
 }
 
+// GetAllTicketAuditsOBP fetches a page of /ticket_audits.json with offset based pagination
 func (z *Client) GetAllTicketAuditsOBP(ctx context.Context, opts *OBPOptions) ([]TicketAudit, Page, error) {
 	var data struct {
-		TicketAudits []TicketAudit `json:"audits"`
+		AllTicketAudits []TicketAudit `json:"audits"`
 		Page
 	}
 
@@ -37,23 +39,23 @@ func (z *Client) GetAllTicketAuditsOBP(ctx context.Context, opts *OBPOptions) ([
 		tmp = &OBPOptions{}
 	}
 
-	u, err := client.AddOptions("/ticket_audits.json", tmp)
-
+	u, err := tmp.addOptions("/ticket_audits.json")
 	if err != nil {
 		return nil, Page{}, err
 	}
 
-	err = client.GetData(z, ctx, u, &data)
+	err = z.getData(ctx, u, &data)
 	if err != nil {
 		return nil, Page{}, err
 	}
-	return data.TicketAudits, data.Page, nil
+	return data.AllTicketAudits, data.Page, nil
 }
 
+// GetAllTicketAuditsCBP fetches a page of /ticket_audits.json with cursor based pagination
 func (z *Client) GetAllTicketAuditsCBP(ctx context.Context, opts *CBPOptions) ([]TicketAudit, client.CursorPaginationMeta, error) {
 	var data struct {
-		TicketAudits []TicketAudit               `json:"audits"`
-		Meta         client.CursorPaginationMeta `json:"meta"`
+		AllTicketAudits []TicketAudit               `json:"audits"`
+		Meta            client.CursorPaginationMeta `json:"meta"`
 	}
 
 	tmp := opts
@@ -61,15 +63,14 @@ func (z *Client) GetAllTicketAuditsCBP(ctx context.Context, opts *CBPOptions) ([
 		tmp = &CBPOptions{}
 	}
 
-	u, err := client.AddOptions("/ticket_audits.json", tmp)
-
+	u, err := tmp.addOptions("/ticket_audits.json")
 	if err != nil {
 		return nil, data.Meta, err
 	}
 
-	err = client.GetData(z, ctx, u, &data)
+	err = z.getData(ctx, u, &data)
 	if err != nil {
 		return nil, data.Meta, err
 	}
-	return data.TicketAudits, data.Meta, nil
+	return data.AllTicketAudits, data.Meta, nil
 }
@@ -5,6 +5,8 @@ import (
 	"net/http"
 )
 
+//go:generate go run ../script/codegen -dir ..
+
 // API an interface containing all the zendesk client methods
 type API interface {
 	AppAPI
@@ -13,21 +15,31 @@ type API interface {
 	client.BaseAPI
 	BrandAPI
 	CustomRoleAPI
+	DeletedTicketAPI
 	DynamicContentAPI
+	GeneratedAPI
 	GroupAPI
 	GroupMembershipAPI
+	IncrementalAPI
+	JobStatusAPI
 	LocaleAPI
 	MacroAPI
 	OrganizationAPI
 	OrganizationFieldAPI
 	OrganizationMembershipAPI
+	ProblemAPI
 	ScheduleAPI
 	SearchAPI
+	SatisfactionRatingAPI
 	SLAPolicyAPI
+	SuspendedTicketAPI
 	TagAPI
 	TargetAPI
 	TicketAuditAPI
 	TicketAPI
+	TicketBulkAPI
+	TicketCollaboratorAPI
+	TicketImportAPI
 	TicketCommentAPI
 	TicketFieldAPI
 	TicketFormAPI
@@ -42,6 +54,8 @@ type API interface {
 
 type Client struct {
 	*client.BaseClient
+
+	keepExtraFields bool
 }
 
 func NewClient(httpClient *http.Client) (*Client, error) {
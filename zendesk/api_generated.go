@@ -0,0 +1,125 @@
+// Code generated by Script. DO NOT EDIT.
+// Source: script/codegen/resources.yaml
+//
+// Generated by this command:
+//
+//	go run ./script/codegen
+
+package zendesk
+
+import (
+	"context"
+
+	"github.com/JacobPotter/go-zendesk/client"
+)
+
+// GeneratedAPI an interface containing all the methods generated from script/codegen/resources.yaml
+type GeneratedAPI interface {
+	GetAutomationsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[Automation]
+	GetAutomationsOBP(ctx context.Context, opts *OBPOptions) ([]Automation, Page, error)
+	GetAutomationsCBP(ctx context.Context, opts *CBPOptions) ([]Automation, client.CursorPaginationMeta, error)
+	GetDeletedTicketsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[DeletedTicket]
+	GetDeletedTicketsOBP(ctx context.Context, opts *OBPOptions) ([]DeletedTicket, Page, error)
+	GetDeletedTicketsCBP(ctx context.Context, opts *CBPOptions) ([]DeletedTicket, client.CursorPaginationMeta, error)
+	IterateDeletedTickets(ctx context.Context, opts *DeletedTicketIteratorOptions) *Iterator[DeletedTicket]
+	GetDynamicContentItemsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[DynamicContentItem]
+	GetDynamicContentItemsOBP(ctx context.Context, opts *OBPOptions) ([]DynamicContentItem, Page, error)
+	GetDynamicContentItemsCBP(ctx context.Context, opts *CBPOptions) ([]DynamicContentItem, client.CursorPaginationMeta, error)
+	GetGroupMembershipsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[GroupMembership]
+	GetGroupMembershipsOBP(ctx context.Context, opts *OBPOptions) ([]GroupMembership, Page, error)
+	GetGroupMembershipsCBP(ctx context.Context, opts *CBPOptions) ([]GroupMembership, client.CursorPaginationMeta, error)
+	GetGroupsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[Group]
+	GetGroupsOBP(ctx context.Context, opts *OBPOptions) ([]Group, Page, error)
+	GetGroupsCBP(ctx context.Context, opts *CBPOptions) ([]Group, client.CursorPaginationMeta, error)
+	IterateGroups(ctx context.Context, opts *GroupIteratorOptions) *Iterator[Group]
+	GetGroup(ctx context.Context, id int64) (Group, error)
+	CreateGroup(ctx context.Context, group Group) (Group, error)
+	UpdateGroup(ctx context.Context, id int64, group Group) (Group, error)
+	DeleteGroup(ctx context.Context, id int64) error
+	GetMacrosIterator(ctx context.Context, opts *PaginationOptions) *Iterator[Macro]
+	GetMacrosOBP(ctx context.Context, opts *OBPOptions) ([]Macro, Page, error)
+	GetMacrosCBP(ctx context.Context, opts *CBPOptions) ([]Macro, client.CursorPaginationMeta, error)
+	GetOrganizationFieldsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[OrganizationField]
+	GetOrganizationFieldsOBP(ctx context.Context, opts *OBPOptions) ([]OrganizationField, Page, error)
+	GetOrganizationFieldsCBP(ctx context.Context, opts *CBPOptions) ([]OrganizationField, client.CursorPaginationMeta, error)
+	IterateOrganizationFields(ctx context.Context, opts *OrganizationFieldIteratorOptions) *Iterator[OrganizationField]
+	GetOrganizationMembershipsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[OrganizationMembership]
+	GetOrganizationMembershipsOBP(ctx context.Context, opts *OBPOptions) ([]OrganizationMembership, Page, error)
+	GetOrganizationMembershipsCBP(ctx context.Context, opts *CBPOptions) ([]OrganizationMembership, client.CursorPaginationMeta, error)
+	GetOrganizationsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[Organization]
+	GetOrganizationsOBP(ctx context.Context, opts *OBPOptions) ([]Organization, Page, error)
+	GetOrganizationsCBP(ctx context.Context, opts *CBPOptions) ([]Organization, client.CursorPaginationMeta, error)
+	IterateOrganizations(ctx context.Context, opts *OrganizationIteratorOptions) *Iterator[Organization]
+	GetSatisfactionRatingsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[SatisfactionRating]
+	GetSatisfactionRatingsOBP(ctx context.Context, opts *OBPOptions) ([]SatisfactionRating, Page, error)
+	GetSatisfactionRatingsCBP(ctx context.Context, opts *CBPOptions) ([]SatisfactionRating, client.CursorPaginationMeta, error)
+	IterateSatisfactionRatings(ctx context.Context, opts *SatisfactionRatingIteratorOptions) *Iterator[SatisfactionRating]
+	GetSatisfactionRating(ctx context.Context, id int64) (SatisfactionRating, error)
+	GetSatisfactionReasonsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[SatisfactionReason]
+	GetSatisfactionReasonsOBP(ctx context.Context, opts *OBPOptions) ([]SatisfactionReason, Page, error)
+	GetSatisfactionReasonsCBP(ctx context.Context, opts *CBPOptions) ([]SatisfactionReason, client.CursorPaginationMeta, error)
+	IterateSatisfactionReasons(ctx context.Context, opts *SatisfactionReasonIteratorOptions) *Iterator[SatisfactionReason]
+	GetSatisfactionReason(ctx context.Context, id int64) (SatisfactionReason, error)
+	GetSearchIterator(ctx context.Context, opts *PaginationOptions) *Iterator[SearchResults]
+	GetSearchOBP(ctx context.Context, opts *OBPOptions) ([]SearchResults, Page, error)
+	GetSearchCBP(ctx context.Context, opts *CBPOptions) ([]SearchResults, client.CursorPaginationMeta, error)
+	GetSLAPoliciesIterator(ctx context.Context, opts *PaginationOptions) *Iterator[SLAPolicy]
+	GetSLAPoliciesOBP(ctx context.Context, opts *OBPOptions) ([]SLAPolicy, Page, error)
+	GetSLAPoliciesCBP(ctx context.Context, opts *CBPOptions) ([]SLAPolicy, client.CursorPaginationMeta, error)
+	GetAllTicketAuditsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[TicketAudit]
+	GetAllTicketAuditsOBP(ctx context.Context, opts *OBPOptions) ([]TicketAudit, Page, error)
+	GetAllTicketAuditsCBP(ctx context.Context, opts *CBPOptions) ([]TicketAudit, client.CursorPaginationMeta, error)
+	GetSuspendedTicketsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[SuspendedTicket]
+	GetSuspendedTicketsOBP(ctx context.Context, opts *OBPOptions) ([]SuspendedTicket, Page, error)
+	GetSuspendedTicketsCBP(ctx context.Context, opts *CBPOptions) ([]SuspendedTicket, client.CursorPaginationMeta, error)
+	IterateSuspendedTickets(ctx context.Context, opts *SuspendedTicketIteratorOptions) *Iterator[SuspendedTicket]
+	GetSuspendedTicket(ctx context.Context, id int64) (SuspendedTicket, error)
+	DeleteSuspendedTicket(ctx context.Context, id int64) error
+	GetTicketAuditsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[TicketAudit]
+	GetTicketAuditsOBP(ctx context.Context, opts *OBPOptions) ([]TicketAudit, Page, error)
+	GetTicketAuditsCBP(ctx context.Context, opts *CBPOptions) ([]TicketAudit, client.CursorPaginationMeta, error)
+	IterateTicketAudits(ctx context.Context, opts *TicketAuditIteratorOptions) *Iterator[TicketAudit]
+	GetTicketFieldsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[TicketField]
+	GetTicketFieldsOBP(ctx context.Context, opts *OBPOptions) ([]TicketField, Page, error)
+	GetTicketFieldsCBP(ctx context.Context, opts *CBPOptions) ([]TicketField, client.CursorPaginationMeta, error)
+	IterateTicketFields(ctx context.Context, opts *TicketFieldIteratorOptions) *Iterator[TicketField]
+	GetTicketFormsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[TicketForm]
+	GetTicketFormsOBP(ctx context.Context, opts *OBPOptions) ([]TicketForm, Page, error)
+	GetTicketFormsCBP(ctx context.Context, opts *CBPOptions) ([]TicketForm, client.CursorPaginationMeta, error)
+	GetTriggersIterator(ctx context.Context, opts *PaginationOptions) *Iterator[Trigger]
+	GetTriggersOBP(ctx context.Context, opts *OBPOptions) ([]Trigger, Page, error)
+	GetTriggersCBP(ctx context.Context, opts *CBPOptions) ([]Trigger, client.CursorPaginationMeta, error)
+	GetUserFieldsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[UserField]
+	GetUserFieldsOBP(ctx context.Context, opts *OBPOptions) ([]UserField, Page, error)
+	GetUserFieldsCBP(ctx context.Context, opts *CBPOptions) ([]UserField, client.CursorPaginationMeta, error)
+	IterateUserFields(ctx context.Context, opts *UserFieldIteratorOptions) *Iterator[UserField]
+	GetUsersIterator(ctx context.Context, opts *PaginationOptions) *Iterator[User]
+	GetUsersOBP(ctx context.Context, opts *OBPOptions) ([]User, Page, error)
+	GetUsersCBP(ctx context.Context, opts *CBPOptions) ([]User, client.CursorPaginationMeta, error)
+	GetOrganizationUsersIterator(ctx context.Context, opts *PaginationOptions) *Iterator[User]
+	GetOrganizationUsersOBP(ctx context.Context, opts *OBPOptions) ([]User, Page, error)
+	GetOrganizationUsersCBP(ctx context.Context, opts *CBPOptions) ([]User, client.CursorPaginationMeta, error)
+	GetViewsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[View]
+	GetViewsOBP(ctx context.Context, opts *OBPOptions) ([]View, Page, error)
+	GetViewsCBP(ctx context.Context, opts *CBPOptions) ([]View, client.CursorPaginationMeta, error)
+	GetTicketsFromViewIterator(ctx context.Context, opts *PaginationOptions) *Iterator[Ticket]
+	GetTicketsFromViewOBP(ctx context.Context, opts *OBPOptions) ([]Ticket, Page, error)
+	GetTicketsFromViewCBP(ctx context.Context, opts *CBPOptions) ([]Ticket, client.CursorPaginationMeta, error)
+	GetTicketsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[Ticket]
+	GetTicketsOBP(ctx context.Context, opts *OBPOptions) ([]Ticket, Page, error)
+	GetTicketsCBP(ctx context.Context, opts *CBPOptions) ([]Ticket, client.CursorPaginationMeta, error)
+	GetTicketCommentsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[TicketComment]
+	GetTicketCommentsOBP(ctx context.Context, opts *OBPOptions) ([]TicketComment, Page, error)
+	GetTicketCommentsCBP(ctx context.Context, opts *CBPOptions) ([]TicketComment, client.CursorPaginationMeta, error)
+	GetOrganizationTicketsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[Ticket]
+	GetOrganizationTicketsOBP(ctx context.Context, opts *OBPOptions) ([]Ticket, Page, error)
+	GetOrganizationTicketsCBP(ctx context.Context, opts *CBPOptions) ([]Ticket, client.CursorPaginationMeta, error)
+	GetProblemsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[Ticket]
+	GetProblemsOBP(ctx context.Context, opts *OBPOptions) ([]Ticket, Page, error)
+	GetProblemsCBP(ctx context.Context, opts *CBPOptions) ([]Ticket, client.CursorPaginationMeta, error)
+	IterateProblems(ctx context.Context, opts *ProblemIteratorOptions) *Iterator[Ticket]
+	GetProblemIncidentsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[Ticket]
+	GetProblemIncidentsOBP(ctx context.Context, opts *OBPOptions) ([]Ticket, Page, error)
+	GetProblemIncidentsCBP(ctx context.Context, opts *CBPOptions) ([]Ticket, client.CursorPaginationMeta, error)
+	IterateProblemIncidents(ctx context.Context, opts *ProblemIncidentIteratorOptions) *Iterator[Ticket]
+}
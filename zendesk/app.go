@@ -3,7 +3,6 @@ package zendesk
 import (
 	"context"
 	"encoding/json"
-	"time"
 )
 
 // AppInstallation is a struct representing an app that has been installed from the Zendesk Marketplace.
@@ -20,15 +19,15 @@ type AppInstallation struct {
 		Name  string `json:"name"`
 		Value string `json:"value"`
 	} `json:"settings_objects"`
-	Enabled                   bool      `json:"enabled"`
-	Updated                   string    `json:"updated"`
-	UpdatedAt                 time.Time `json:"updated_at"`
-	CreatedAt                 time.Time `json:"created_at"`
-	RecurringPayment          bool      `json:"recurring_payment"`
-	Collapsible               bool      `json:"collapsible"`
-	Paid                      bool      `json:"paid"`
-	HasUnpaidSubscription     bool      `json:"has_unpaid_subscription"`
-	HasIncompleteSubscription bool      `json:"has_incomplete_subscription"`
+	Enabled                   bool       `json:"enabled"`
+	Updated                   *Timestamp `json:"updated,omitempty"`
+	UpdatedAt                 *Timestamp `json:"updated_at,omitempty"`
+	CreatedAt                 *Timestamp `json:"created_at,omitempty"`
+	RecurringPayment          bool       `json:"recurring_payment"`
+	Collapsible               bool       `json:"collapsible"`
+	Paid                      bool       `json:"paid"`
+	HasUnpaidSubscription     bool       `json:"has_unpaid_subscription"`
+	HasIncompleteSubscription bool       `json:"has_incomplete_subscription"`
 }
 
 // AppAPI is an interface containing all methods associated with zendesk apps
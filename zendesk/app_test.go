@@ -46,8 +46,8 @@ func TestListAppInstallations(t *testing.T) {
 			},
 			Enabled:   true,
 			Paid:      false,
-			UpdatedAt: time.Date(2023, 1, 1, 1, 1, 1, 0, time.UTC),
-			CreatedAt: time.Date(2023, 1, 1, 1, 1, 1, 0, time.UTC),
+			UpdatedAt: NewTimestamp(time.Date(2023, 1, 1, 1, 1, 1, 0, time.UTC)),
+			CreatedAt: NewTimestamp(time.Date(2023, 1, 1, 1, 1, 1, 0, time.UTC)),
 		},
 		{
 			ID:      42,
@@ -71,8 +71,8 @@ func TestListAppInstallations(t *testing.T) {
 			},
 			Enabled:   true,
 			Paid:      false,
-			UpdatedAt: time.Date(2023, 2, 2, 2, 2, 2, 0, time.UTC),
-			CreatedAt: time.Date(2023, 2, 2, 2, 2, 2, 0, time.UTC),
+			UpdatedAt: NewTimestamp(time.Date(2023, 2, 2, 2, 2, 2, 0, time.UTC)),
+			CreatedAt: NewTimestamp(time.Date(2023, 2, 2, 2, 2, 2, 0, time.UTC)),
 		},
 	}
 
@@ -0,0 +1,213 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// AuditEvent is an event of a ticket audit. It is one of the *XxxEvent types of this file, or an
+// *UnknownAuditEvent for the event types which are not modeled.
+//
+// ref: https://developer.zendesk.com/documentation/ticketing/reference-guides/ticket-audit-events-reference/
+type AuditEvent interface {
+	EventID() int64
+	EventType() string
+}
+
+// AuditEventBase holds the fields common to every audit event
+type AuditEventBase struct {
+	ID   int64  `json:"id,omitempty"`
+	Type string `json:"type"`
+}
+
+// EventID returns the id of the event
+func (e AuditEventBase) EventID() int64 {
+	return e.ID
+}
+
+// EventType returns the type of the event, e.g. "Change"
+func (e AuditEventBase) EventType() string {
+	return e.Type
+}
+
+// CreateEvent records the value of a field when the ticket was created
+type CreateEvent struct {
+	AuditEventBase
+	FieldName string      `json:"field_name"`
+	Value     interface{} `json:"value"`
+}
+
+// ChangeEvent records the change of a field of the ticket. Value and PreviousValue are a string, a list
+// of strings such as for tags, or nil.
+type ChangeEvent struct {
+	AuditEventBase
+	FieldName     string      `json:"field_name"`
+	Value         interface{} `json:"value"`
+	PreviousValue interface{} `json:"previous_value"`
+	Via           *Via        `json:"via,omitempty"`
+}
+
+// CommentEvent records a comment added to the ticket, including voice comments
+type CommentEvent struct {
+	AuditEventBase
+	AuthorID    int64                  `json:"author_id,omitempty"`
+	Body        string                 `json:"body,omitempty"`
+	HTMLBody    string                 `json:"html_body,omitempty"`
+	PlainBody   string                 `json:"plain_body,omitempty"`
+	Public      bool                   `json:"public"`
+	Attachments []Attachment           `json:"attachments,omitempty"`
+	AuditID     int64                  `json:"audit_id,omitempty"`
+	Metadata    map[string]interface{} `json:"metadata,omitempty"`
+	Via         *Via                   `json:"via,omitempty"`
+}
+
+// CommentPrivacyChangeEvent records a comment made public or private
+type CommentPrivacyChangeEvent struct {
+	AuditEventBase
+	CommentID int64 `json:"comment_id"`
+	Public    bool  `json:"public"`
+}
+
+// NotificationEvent records an email notification sent by a business rule
+type NotificationEvent struct {
+	AuditEventBase
+	Subject    string  `json:"subject,omitempty"`
+	Body       string  `json:"body,omitempty"`
+	Recipients []int64 `json:"recipients,omitempty"`
+	Via        *Via    `json:"via,omitempty"`
+}
+
+// CcEvent records a CC notification sent by a business rule
+type CcEvent struct {
+	AuditEventBase
+	Recipients []int64 `json:"recipients,omitempty"`
+	Via        *Via    `json:"via,omitempty"`
+}
+
+// SatisfactionRatingEvent records a satisfaction rating given by the requester
+type SatisfactionRatingEvent struct {
+	AuditEventBase
+	Score      string `json:"score,omitempty"`
+	AssigneeID int64  `json:"assignee_id,omitempty"`
+	Body       string `json:"body,omitempty"`
+}
+
+// WebhookEvent records a webhook notified by a business rule
+type WebhookEvent struct {
+	AuditEventBase
+	WebhookID string `json:"webhook_id,omitempty"`
+	Body      string `json:"body,omitempty"`
+	Via       *Via   `json:"via,omitempty"`
+}
+
+// ExternalEvent records a target notified by a business rule
+type ExternalEvent struct {
+	AuditEventBase
+	Resource string `json:"resource,omitempty"`
+	Body     string `json:"body,omitempty"`
+}
+
+// TicketSharingEvent records the ticket being shared with another account
+type TicketSharingEvent struct {
+	AuditEventBase
+	AgreementID int64  `json:"agreement_id,omitempty"`
+	Action      string `json:"action,omitempty"`
+}
+
+// ErrorEvent records an error which happened while processing the ticket, e.g. in a business rule
+type ErrorEvent struct {
+	AuditEventBase
+	Message string `json:"message,omitempty"`
+}
+
+// UnknownAuditEvent is an audit event of a type which is not modeled. Raw is the event as returned by
+// Zendesk, and is written back as is.
+type UnknownAuditEvent struct {
+	AuditEventBase
+	Raw json.RawMessage `json:"-"`
+}
+
+// MarshalJSON is marshaller for UnknownAuditEvent
+func (e UnknownAuditEvent) MarshalJSON() ([]byte, error) {
+	if len(e.Raw) == 0 {
+		return json.Marshal(e.AuditEventBase)
+	}
+	return e.Raw, nil
+}
+
+// auditEventTypes creates the event of each modeled type
+var auditEventTypes = map[string]func() AuditEvent{
+	"Create":               func() AuditEvent { return &CreateEvent{} },
+	"Change":               func() AuditEvent { return &ChangeEvent{} },
+	"Comment":              func() AuditEvent { return &CommentEvent{} },
+	"VoiceComment":         func() AuditEvent { return &CommentEvent{} },
+	"CommentPrivacyChange": func() AuditEvent { return &CommentPrivacyChangeEvent{} },
+	"Notification":         func() AuditEvent { return &NotificationEvent{} },
+	"Cc":                   func() AuditEvent { return &CcEvent{} },
+	"SatisfactionRating":   func() AuditEvent { return &SatisfactionRatingEvent{} },
+	"WebhookEvent":         func() AuditEvent { return &WebhookEvent{} },
+	"External":             func() AuditEvent { return &ExternalEvent{} },
+	"TicketSharingEvent":   func() AuditEvent { return &TicketSharingEvent{} },
+	"Error":                func() AuditEvent { return &ErrorEvent{} },
+}
+
+// AuditEvents is the list of events of a ticket audit, decoded by type
+type AuditEvents []AuditEvent
+
+// UnmarshalJSON is unmarshaller for AuditEvents, which decodes every event into the type matching
+// its "type", or into an *UnknownAuditEvent
+func (events *AuditEvents) UnmarshalJSON(data []byte) error {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	decoded := make(AuditEvents, 0, len(raw))
+	for _, item := range raw {
+		var base AuditEventBase
+		if err := json.Unmarshal(item, &base); err != nil {
+			return err
+		}
+
+		newEvent, ok := auditEventTypes[base.Type]
+		if !ok {
+			decoded = append(decoded, &UnknownAuditEvent{AuditEventBase: base, Raw: item})
+			continue
+		}
+
+		event := newEvent()
+		if err := json.Unmarshal(item, event); err != nil {
+			return fmt.Errorf("audit event %d of type %s: %w", base.ID, base.Type, err)
+		}
+		decoded = append(decoded, event)
+	}
+
+	*events = decoded
+	return nil
+}
+
+// Changes returns the change events of the audit
+func (a TicketAudit) Changes() []*ChangeEvent {
+	return auditEventsOf[*ChangeEvent](a.Events)
+}
+
+// CreateEvents returns the create events of the audit, which hold the values of the ticket when it was created
+func (a TicketAudit) CreateEvents() []*CreateEvent {
+	return auditEventsOf[*CreateEvent](a.Events)
+}
+
+// CommentEvents returns the comment events of the audit
+func (a TicketAudit) CommentEvents() []*CommentEvent {
+	return auditEventsOf[*CommentEvent](a.Events)
+}
+
+// auditEventsOf returns the events of type T
+func auditEventsOf[T AuditEvent](events AuditEvents) []T {
+	var matching []T
+	for _, event := range events {
+		if e, ok := event.(T); ok {
+			matching = append(matching, e)
+		}
+	}
+	return matching
+}
@@ -0,0 +1,80 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/JacobPotter/go-zendesk/testhelper"
+)
+
+func TestTicketAuditEvents(t *testing.T) {
+	mockAPI := testhelper.NewMockAPI(t, http.MethodGet, "ticket_audit.json")
+	c := NewTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	audit, err := c.GetTicketAudit(ctx, 666, 2127301143)
+	if err != nil {
+		t.Fatalf("Failed to get ticket audit: %s", err)
+	}
+
+	comments := audit.CommentEvents()
+	if len(comments) != 1 || comments[0].Body != "This is a new private comment" || comments[0].Public {
+		t.Fatalf("Unexpected comment events: %v", comments)
+	}
+
+	changes := audit.Changes()
+	if len(changes) != 1 {
+		t.Fatalf("Expected 1 change, got %d", len(changes))
+	}
+	change := changes[0]
+	if change.EventID() != 2127301163 || change.FieldName != "status" || change.PreviousValue != "new" || change.Value != "open" {
+		t.Fatalf("Unexpected change event: %v", change)
+	}
+	if change.Via == nil || change.Via.Source.Rel != "trigger" {
+		t.Fatalf("Unexpected via of the change: %v", change.Via)
+	}
+
+	if len(audit.CreateEvents()) != 0 {
+		t.Fatal("Expected no create events")
+	}
+}
+
+func TestAuditEventsUnknownType(t *testing.T) {
+	data := `[{"id":1,"type":"Create","field_name":"tags","value":["vip","billing"]},` +
+		`{"id":2,"type":"Error","message":"Webhook failed"},` +
+		`{"id":3,"type":"OrganizationActivity","recipients":[4],"subject":"New ticket"}]`
+
+	var events AuditEvents
+	if err := json.Unmarshal([]byte(data), &events); err != nil {
+		t.Fatalf("Failed to unmarshal events: %s", err)
+	}
+	if len(events) != 3 {
+		t.Fatalf("Expected 3 events, got %d", len(events))
+	}
+
+	create, ok := events[0].(*CreateEvent)
+	if !ok || create.FieldName != "tags" || len(create.Value.([]interface{})) != 2 {
+		t.Fatalf("Unexpected create event: %#v", events[0])
+	}
+	if e, ok := events[1].(*ErrorEvent); !ok || e.Message != "Webhook failed" {
+		t.Fatalf("Unexpected error event: %#v", events[1])
+	}
+
+	unknown, ok := events[2].(*UnknownAuditEvent)
+	if !ok || unknown.EventType() != "OrganizationActivity" || unknown.EventID() != 3 {
+		t.Fatalf("Unexpected unknown event: %#v", events[2])
+	}
+
+	b, err := json.Marshal(events)
+	if err != nil {
+		t.Fatalf("Failed to marshal events: %s", err)
+	}
+	var roundTrip AuditEvents
+	if err := json.Unmarshal(b, &roundTrip); err != nil {
+		t.Fatalf("Failed to unmarshal marshaled events: %s", err)
+	}
+	if u, ok := roundTrip[2].(*UnknownAuditEvent); !ok || string(u.Raw) != string(unknown.Raw) {
+		t.Fatalf("Unknown event was not written back as is: %s", b)
+	}
+}
@@ -2,10 +2,8 @@ package zendesk
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	client2 "github.com/JacobPotter/go-zendesk/client"
-	"time"
 )
 
 // Automation is zendesk automation JSON payload format
@@ -20,9 +18,12 @@ type Automation struct {
 	Conditions  Conditions `json:"conditions"`
 	Actions     []Action   `json:"actions"`
 	RawTitle    string     `json:"raw_title,omitempty"`
-	CreatedAt   *time.Time `json:"created_at,omitempty"`
-	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+	CreatedAt   *Timestamp `json:"created_at,omitempty"`
+	UpdatedAt   *Timestamp `json:"updated_at,omitempty"`
 	URL         string     `json:"url,omitempty"`
+
+	// Extra keeps the automation fields this struct lacks, see SetKeepExtraFields
+	Extra ExtraFields `json:"-"`
 }
 
 // AutomationListOptions is options for GetAutomations
@@ -70,7 +71,7 @@ func (z *Client) GetAutomations(ctx context.Context, opts *AutomationListOptions
 		return []Automation{}, Page{}, err
 	}
 
-	err = json.Unmarshal(body, &data)
+	err = z.unmarshal(body, &data)
 	if err != nil {
 		return []Automation{}, Page{}, err
 	}
@@ -87,13 +88,13 @@ func (z *Client) CreateAutomation(ctx context.Context, automation Automation) (A
 	}
 
 	data.Automation = automation
-	body, err := z.Post(ctx, "/automations.json", data)
+	body, err := z.Post(ctx, "/automations.json", withExtraFields(data))
 
 	if err != nil {
 		return Automation{}, err
 	}
 
-	err = json.Unmarshal(body, &result)
+	err = z.unmarshal(body, &result)
 	if err != nil {
 		return Automation{}, err
 	}
@@ -114,7 +115,7 @@ func (z *Client) GetAutomation(ctx context.Context, id int64) (Automation, error
 		return Automation{}, err
 	}
 
-	err = json.Unmarshal(body, &result)
+	err = z.unmarshal(body, &result)
 	if err != nil {
 		return Automation{}, err
 	}
@@ -131,13 +132,13 @@ func (z *Client) UpdateAutomation(ctx context.Context, id int64, automation Auto
 	}
 
 	data.Automation = automation
-	body, err := z.Put(ctx, fmt.Sprintf("/automations/%d.json", id), data)
+	body, err := z.Put(ctx, fmt.Sprintf("/automations/%d.json", id), withExtraFields(data))
 
 	if err != nil {
 		return Automation{}, err
 	}
 
-	err = json.Unmarshal(body, &result)
+	err = z.unmarshal(body, &result)
 	if err != nil {
 		return Automation{}, err
 	}
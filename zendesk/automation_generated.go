@@ -1,9 +1,9 @@
 // Code generated by Script. DO NOT EDIT.
-// Source: script/codegen/main.go
+// Source: script/codegen/resources.yaml
 //
 // Generated by this command:
 //
-//	go run script/codegen/main.go
+//	go run ./script/codegen
 
 package zendesk
 
@@ -12,6 +12,7 @@ import (
 	"github.com/JacobPotter/go-zendesk/client"
 )
 
+// GetAutomationsIterator returns an Iterator over /automation.json
 func (z *Client) GetAutomationsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[Automation] {
 	return &Iterator[Automation]{
 		CommonOptions: opts.CommonOptions,
@@ -26,6 +27,7 @@ func (z *Client) GetAutomationsIterator(ctx context.Context, opts *PaginationOpt
 	}
 }
 
+// GetAutomationsOBP fetches a page of /automation.json with offset based pagination
 func (z *Client) GetAutomationsOBP(ctx context.Context, opts *OBPOptions) ([]Automation, Page, error) {
 	var data struct {
 		Automations []Automation `json:"automations"`
@@ -37,19 +39,19 @@ func (z *Client) GetAutomationsOBP(ctx context.Context, opts *OBPOptions) ([]Aut
 		tmp = &OBPOptions{}
 	}
 
-	u, err := client.AddOptions("/automation.json", tmp)
-
+	u, err := tmp.addOptions("/automation.json")
 	if err != nil {
 		return nil, Page{}, err
 	}
 
-	err = client.GetData(z, ctx, u, &data)
+	err = z.getData(ctx, u, &data)
 	if err != nil {
 		return nil, Page{}, err
 	}
 	return data.Automations, data.Page, nil
 }
 
+// GetAutomationsCBP fetches a page of /automation.json with cursor based pagination
 func (z *Client) GetAutomationsCBP(ctx context.Context, opts *CBPOptions) ([]Automation, client.CursorPaginationMeta, error) {
 	var data struct {
 		Automations []Automation                `json:"automations"`
@@ -61,13 +63,12 @@ func (z *Client) GetAutomationsCBP(ctx context.Context, opts *CBPOptions) ([]Aut
 		tmp = &CBPOptions{}
 	}
 
-	u, err := client.AddOptions("/automation.json", tmp)
-
+	u, err := tmp.addOptions("/automation.json")
 	if err != nil {
 		return nil, data.Meta, err
 	}
 
-	err = client.GetData(z, ctx, u, &data)
+	err = z.getData(ctx, u, &data)
 	if err != nil {
 		return nil, data.Meta, err
 	}
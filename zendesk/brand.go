@@ -4,7 +4,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"time"
 )
 
 // Brand is struct for brand payload
@@ -23,8 +22,8 @@ type Brand struct {
 	Subdomain         string     `json:"subdomain"`
 	HostMapping       string     `json:"host_mapping,omitempty"`
 	SignatureTemplate string     `json:"signature_template"`
-	CreatedAt         time.Time  `json:"created_at,omitempty"`
-	UpdatedAt         time.Time  `json:"updated_at,omitempty"`
+	CreatedAt         *Timestamp `json:"created_at,omitempty"`
+	UpdatedAt         *Timestamp `json:"updated_at,omitempty"`
 }
 
 // BrandAPI an interface containing all methods associated with zendesk brands
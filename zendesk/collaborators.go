@@ -1,13 +1,16 @@
 package zendesk
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"reflect"
 )
 
-// Collaborator is user information for collaborator field value
+// Collaborator is user information for collaborator field value. ID is only set by Collaborators.Items
+// for collaborators given by id.
 type Collaborator struct {
+	ID    int64  `json:"-"`
 	Name  string `json:"name,omitempty"`
 	Email string `json:"email,omitempty"`
 }
@@ -29,6 +32,23 @@ func (c *Collaborators) List() []interface{} {
 	return c.collaborators
 }
 
+// Items returns the collaborators as Collaborator values. Collaborators given by id only have an ID,
+// the ones given by email only have an Email.
+func (c *Collaborators) Items() []Collaborator {
+	items := make([]Collaborator, 0, len(c.collaborators))
+	for _, i := range c.collaborators {
+		switch e := i.(type) {
+		case int64:
+			items = append(items, Collaborator{ID: e})
+		case string:
+			items = append(items, Collaborator{Email: e})
+		case Collaborator:
+			items = append(items, e)
+		}
+	}
+	return items
+}
+
 // Append add any type of collaborator data payload to Collaborators.
 // The type can be string, int64, Collaborator or map[string]interface{}
 // which must include "name" and "email" field
@@ -37,6 +57,10 @@ func (c *Collaborators) Append(i interface{}) error {
 	case string:
 		c.collaborators = append(c.collaborators, e)
 	case Collaborator:
+		if e.ID != 0 && e.Email == "" {
+			c.collaborators = append(c.collaborators, e.ID)
+			break
+		}
 		c.collaborators = append(c.collaborators, e)
 	case int64:
 		c.collaborators = append(c.collaborators, e)
@@ -100,3 +124,90 @@ func (c *Collaborators) UnmarshalJSON(b []byte) error {
 	c.collaborators = newCollaborators.List()
 	return nil
 }
+
+// TicketUser identifies a follower or email CC of a ticket by ID or by Email. Name is used when a user
+// is created for the email of a new email CC.
+type TicketUser struct {
+	ID    int64
+	Email string
+	Name  string
+}
+
+// TicketCollaboratorAPI an interface containing the methods managing the collaborators, followers and
+// email CCs of a ticket
+type TicketCollaboratorAPI interface {
+	GetTicketCollaborators(ctx context.Context, ticketID int64) ([]User, error)
+	GetTicketFollowers(ctx context.Context, ticketID int64) ([]User, error)
+	GetTicketEmailCCs(ctx context.Context, ticketID int64) ([]User, error)
+	AddTicketFollowers(ctx context.Context, ticketID int64, users ...TicketUser) (Ticket, error)
+	RemoveTicketFollowers(ctx context.Context, ticketID int64, users ...TicketUser) (Ticket, error)
+	AddTicketEmailCCs(ctx context.Context, ticketID int64, users ...TicketUser) (Ticket, error)
+	RemoveTicketEmailCCs(ctx context.Context, ticketID int64, users ...TicketUser) (Ticket, error)
+}
+
+// GetTicketCollaborators lists the collaborators of a ticket, which are its followers and email CCs
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/tickets/#list-collaborators-for-a-ticket
+func (z *Client) GetTicketCollaborators(ctx context.Context, ticketID int64) ([]User, error) {
+	return z.getTicketUsers(ctx, fmt.Sprintf("/tickets/%d/collaborators.json", ticketID))
+}
+
+// GetTicketFollowers lists the followers of a ticket
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/tickets/#list-followers-for-a-ticket
+func (z *Client) GetTicketFollowers(ctx context.Context, ticketID int64) ([]User, error) {
+	return z.getTicketUsers(ctx, fmt.Sprintf("/tickets/%d/followers.json", ticketID))
+}
+
+// GetTicketEmailCCs lists the email CCs of a ticket
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/tickets/#list-email-ccs-for-a-ticket
+func (z *Client) GetTicketEmailCCs(ctx context.Context, ticketID int64) ([]User, error) {
+	return z.getTicketUsers(ctx, fmt.Sprintf("/tickets/%d/email_ccs.json", ticketID))
+}
+
+// AddTicketFollowers adds followers to a ticket, keeping its other followers
+//
+// ref: https://developer.zendesk.com/documentation/ticketing/managing-tickets/creating-and-managing-ccs-and-followers/
+func (z *Client) AddTicketFollowers(ctx context.Context, ticketID int64, users ...TicketUser) (Ticket, error) {
+	return z.UpdateTicketWith(ctx, ticketID, NewTicketUpdate().AddFollowerUsers(users...))
+}
+
+// RemoveTicketFollowers removes followers from a ticket, keeping its other followers
+//
+// ref: https://developer.zendesk.com/documentation/ticketing/managing-tickets/creating-and-managing-ccs-and-followers/
+func (z *Client) RemoveTicketFollowers(ctx context.Context, ticketID int64, users ...TicketUser) (Ticket, error) {
+	return z.UpdateTicketWith(ctx, ticketID, NewTicketUpdate().RemoveFollowerUsers(users...))
+}
+
+// AddTicketEmailCCs adds email CCs to a ticket, keeping its other CCs. Users are created for unknown emails.
+//
+// ref: https://developer.zendesk.com/documentation/ticketing/managing-tickets/creating-and-managing-ccs-and-followers/
+func (z *Client) AddTicketEmailCCs(ctx context.Context, ticketID int64, users ...TicketUser) (Ticket, error) {
+	return z.UpdateTicketWith(ctx, ticketID, NewTicketUpdate().AddEmailCCUsers(users...))
+}
+
+// RemoveTicketEmailCCs removes email CCs from a ticket, keeping its other CCs
+//
+// ref: https://developer.zendesk.com/documentation/ticketing/managing-tickets/creating-and-managing-ccs-and-followers/
+func (z *Client) RemoveTicketEmailCCs(ctx context.Context, ticketID int64, users ...TicketUser) (Ticket, error) {
+	return z.UpdateTicketWith(ctx, ticketID, NewTicketUpdate().RemoveEmailCCUsers(users...))
+}
+
+// getTicketUsers gets the {"users": [...]} at path
+func (z *Client) getTicketUsers(ctx context.Context, path string) ([]User, error) {
+	var result struct {
+		Users []User `json:"users"`
+	}
+
+	body, err := z.Get(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.Users, nil
+}
@@ -1,9 +1,16 @@
 package zendesk
 
 import (
+	"context"
 	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
 	"reflect"
 	"testing"
+
+	"github.com/JacobPotter/go-zendesk/testhelper"
 )
 
 const collaboratorListJSON = `[562,"someone@example.com",{"name":"SomeoneElse","email":"else@example.com"}]`
@@ -64,3 +71,91 @@ func TestCanBeRemarshalled(t *testing.T) {
 		t.Fatalf("remarshalling is inconsistent")
 	}
 }
+
+func TestCollaboratorsItems(t *testing.T) {
+	c := &Collaborators{}
+	if err := c.UnmarshalJSON([]byte(collaboratorListJSON)); err != nil {
+		t.Fatalf("Unmarshal returned an error %v", err)
+	}
+	if err := c.Append(Collaborator{ID: 563}); err != nil {
+		t.Fatalf("Append returned an error %v", err)
+	}
+
+	expected := []Collaborator{
+		{ID: 562},
+		{Email: "someone@example.com"},
+		{Name: "SomeoneElse", Email: "else@example.com"},
+		{ID: 563},
+	}
+	if items := c.Items(); !reflect.DeepEqual(items, expected) {
+		t.Fatalf("Unexpected items %v", items)
+	}
+
+	out, _ := json.Marshal(c)
+	if string(out) != `[562,"someone@example.com",{"name":"SomeoneElse","email":"else@example.com"},563]` {
+		t.Fatalf("Unexpected collaborators JSON %s", out)
+	}
+}
+
+func TestGetTicketFollowers(t *testing.T) {
+	var paths []string
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		_, _ = w.Write(testhelper.ReadFixture(t, filepath.Join(http.MethodGet, "users.json")))
+	}))
+	c := NewTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	for _, get := range []func(context.Context, int64) ([]User, error){
+		c.GetTicketCollaborators, c.GetTicketFollowers, c.GetTicketEmailCCs,
+	} {
+		users, err := get(ctx, 2)
+		if err != nil {
+			t.Fatalf("Failed to get users: %s", err)
+		}
+		if len(users) != 2 {
+			t.Fatalf("Expected 2 users, got %d", len(users))
+		}
+	}
+
+	expected := []string{"/tickets/2/collaborators.json", "/tickets/2/followers.json", "/tickets/2/email_ccs.json"}
+	if !reflect.DeepEqual(paths, expected) {
+		t.Fatalf("Unexpected paths %v", paths)
+	}
+}
+
+func TestAddAndRemoveTicketEmailCCs(t *testing.T) {
+	var bodies []string
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		_, _ = w.Write(testhelper.ReadFixture(t, filepath.Join(http.MethodPut, "ticket.json")))
+	}))
+	c := NewTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := c.AddTicketEmailCCs(ctx, 2, TicketUser{ID: 10}, TicketUser{Email: "new@example.com", Name: "New"})
+	if err != nil {
+		t.Fatalf("Failed to add email CCs: %s", err)
+	}
+	_, err = c.RemoveTicketFollowers(ctx, 2, TicketUser{Email: "agent@example.com"})
+	if err != nil {
+		t.Fatalf("Failed to remove followers: %s", err)
+	}
+
+	expected := []string{
+		`{"ticket":{"email_ccs":[{"user_id":10,"action":"put"},` +
+			`{"user_email":"new@example.com","user_name":"New","action":"put"}]}}`,
+		`{"ticket":{"followers":[{"user_email":"agent@example.com","action":"delete"}]}}`,
+	}
+	if !reflect.DeepEqual(bodies, expected) {
+		t.Fatalf("Unexpected updates %v", bodies)
+	}
+
+	if _, err := c.AddTicketFollowers(ctx, 2, TicketUser{Name: "Nobody"}); err == nil {
+		t.Fatal("Expected an error for a follower without id or email")
+	}
+	if len(bodies) != 2 {
+		t.Fatal("An invalid update must not be sent")
+	}
+}
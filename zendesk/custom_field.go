@@ -0,0 +1,313 @@
+package zendesk
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// customFieldDateLayout is the layout of the values of date custom fields
+const customFieldDateLayout = "2006-01-02"
+
+// CustomField is the value of a custom field of a ticket.
+//
+// Value holds a string (text, textarea, regexp, tagger, date and lookup fields, and integer and decimal
+// fields returned as strings), a json.Number (integer, decimal and lookup fields returned as numbers),
+// a bool (checkbox fields), a []string (multiselect fields) or nil. Use Typed or the typed accessors
+// rather than type switching on Value, and SetCustomField or NewCustomField to set values.
+type CustomField struct {
+	ID    int64       `json:"id"`
+	Value interface{} `json:"value"`
+}
+
+// NewCustomField returns the custom field id set to value, which can be any of the types held by
+// CustomField.Value, an integer or float, a time.Time for date fields or a CustomFieldValue
+func NewCustomField(id int64, value interface{}) (CustomField, error) {
+	switch v := value.(type) {
+	case CustomFieldValue:
+		raw, err := v.value()
+		if err != nil {
+			return CustomField{}, fmt.Errorf("custom field %d: %w", id, err)
+		}
+		return NewCustomField(id, raw)
+	case nil, string, bool, []string, json.Number:
+		return CustomField{ID: id, Value: v}, nil
+	case int:
+		return CustomField{ID: id, Value: json.Number(strconv.Itoa(v))}, nil
+	case int32:
+		return CustomField{ID: id, Value: json.Number(strconv.FormatInt(int64(v), 10))}, nil
+	case int64:
+		return CustomField{ID: id, Value: json.Number(strconv.FormatInt(v, 10))}, nil
+	case float32:
+		return CustomField{ID: id, Value: json.Number(strconv.FormatFloat(float64(v), 'f', -1, 32))}, nil
+	case float64:
+		return CustomField{ID: id, Value: json.Number(strconv.FormatFloat(v, 'f', -1, 64))}, nil
+	case time.Time:
+		return CustomField{ID: id, Value: v.Format(customFieldDateLayout)}, nil
+	default:
+		return CustomField{}, fmt.Errorf("%T is an invalid type for custom field %d", value, id)
+	}
+}
+
+// UnmarshalJSON Custom Unmarshal function required because a custom field's value can be
+// a string, number, bool, array of strings or null.
+func (cf *CustomField) UnmarshalJSON(data []byte) error {
+	var temp struct {
+		ID    *int64          `json:"id"`
+		Value json.RawMessage `json:"value"`
+	}
+	if err := json.Unmarshal(data, &temp); err != nil {
+		return err
+	}
+	if temp.ID == nil {
+		return errors.New("custom field has no id")
+	}
+	cf.ID = *temp.ID
+
+	if len(temp.Value) == 0 {
+		cf.Value = nil
+		return nil
+	}
+
+	var value interface{}
+	d := json.NewDecoder(bytes.NewReader(temp.Value))
+	d.UseNumber()
+	if err := d.Decode(&value); err != nil {
+		return err
+	}
+
+	switch v := value.(type) {
+	case string, json.Number, nil, bool:
+		cf.Value = v
+	case []interface{}:
+		list := make([]string, 0, len(v))
+		for _, item := range v {
+			s, ok := item.(string)
+			if !ok {
+				return fmt.Errorf("%T is an invalid type for custom field %d value", item, cf.ID)
+			}
+			list = append(list, s)
+		}
+		cf.Value = list
+	default:
+		return fmt.Errorf("%T is an invalid type for custom field %d value", v, cf.ID)
+	}
+
+	return nil
+}
+
+// clone returns a copy of cf which does not share its multiselect tags with cf. The other values held
+// by CustomField.Value are immutable.
+func (cf CustomField) clone() CustomField {
+	if list, ok := cf.Value.([]string); ok {
+		cf.Value = slices.Clone(list)
+	}
+	return cf
+}
+
+// IsNull reports whether the field has no value
+func (cf CustomField) IsNull() bool {
+	return cf.Value == nil
+}
+
+// Text returns the value of a text, textarea or regexp field
+func (cf CustomField) Text() (string, bool) {
+	s, ok := cf.Value.(string)
+	return s, ok
+}
+
+// Tagger returns the tag of the option selected in a drop-down field
+func (cf CustomField) Tagger() (string, bool) {
+	return cf.Text()
+}
+
+// Integer returns the value of an integer field
+func (cf CustomField) Integer() (int64, bool) {
+	switch v := cf.Value.(type) {
+	case json.Number:
+		i, err := v.Int64()
+		return i, err == nil
+	case string:
+		i, err := strconv.ParseInt(v, 10, 64)
+		return i, err == nil
+	}
+	return 0, false
+}
+
+// Decimal returns the value of a decimal field
+func (cf CustomField) Decimal() (float64, bool) {
+	switch v := cf.Value.(type) {
+	case json.Number:
+		f, err := v.Float64()
+		return f, err == nil
+	case string:
+		f, err := strconv.ParseFloat(v, 64)
+		return f, err == nil
+	}
+	return 0, false
+}
+
+// Date returns the value of a date field
+func (cf CustomField) Date() (time.Time, bool) {
+	s, ok := cf.Value.(string)
+	if !ok {
+		return time.Time{}, false
+	}
+	d, err := time.Parse(customFieldDateLayout, s)
+	return d, err == nil
+}
+
+// Checkbox returns the value of a checkbox field
+func (cf CustomField) Checkbox() (bool, bool) {
+	b, ok := cf.Value.(bool)
+	return b, ok
+}
+
+// MultiSelect returns the tags of the options selected in a multiselect field
+func (cf CustomField) MultiSelect() ([]string, bool) {
+	list, ok := cf.Value.([]string)
+	return list, ok
+}
+
+// Lookup returns the id of the record referenced by a lookup relationship field
+func (cf CustomField) Lookup() (int64, bool) {
+	return cf.Integer()
+}
+
+// String returns the value formatted as text: multiselect tags are joined by commas and
+// a null value is empty
+func (cf CustomField) String() string {
+	switch v := cf.Value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case bool:
+		return strconv.FormatBool(v)
+	case []string:
+		return strings.Join(v, ",")
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// CustomFieldValue is the value of a custom field typed after the type of the field, which callers
+// can switch on. Unless Null is set, Type selects the field holding the value:
+//   - Text for text, textarea, regexp, partial_credit_card and tagger fields
+//   - Integer for integer and lookup fields
+//   - Decimal, Date, Checkbox and MultiSelect for the fields of these types
+type CustomFieldValue struct {
+	Type TicketFieldType
+	Null bool
+
+	Text        string
+	Integer     int64
+	Decimal     float64
+	Date        time.Time
+	Checkbox    bool
+	MultiSelect []string
+}
+
+// Typed returns the value of the field as a CustomFieldValue of the given field type. It returns an
+// error if the type is not a custom field type or the value does not match it.
+func (cf CustomField) Typed(fieldType TicketFieldType) (CustomFieldValue, error) {
+	v := CustomFieldValue{Type: fieldType, Null: cf.IsNull()}
+	if v.Null {
+		if _, err := v.value(); err != nil {
+			return CustomFieldValue{}, fmt.Errorf("custom field %d: %w", cf.ID, err)
+		}
+		return v, nil
+	}
+
+	var ok bool
+	switch fieldType {
+	case Text, TextArea, Regexp, PartialCreditCard, Tagger:
+		v.Text, ok = cf.Text()
+	case Integer:
+		v.Integer, ok = cf.Integer()
+	case Lookup:
+		v.Integer, ok = cf.Lookup()
+	case Decimal:
+		v.Decimal, ok = cf.Decimal()
+	case Date:
+		v.Date, ok = cf.Date()
+	case Checkbox:
+		v.Checkbox, ok = cf.Checkbox()
+	case Multiselect:
+		v.MultiSelect, ok = cf.MultiSelect()
+	default:
+		return CustomFieldValue{}, fmt.Errorf("custom field %d: %q is not a custom field type", cf.ID, fieldType)
+	}
+	if !ok {
+		return CustomFieldValue{}, fmt.Errorf("custom field %d: %T is an invalid value for a %s field",
+			cf.ID, cf.Value, fieldType)
+	}
+	return v, nil
+}
+
+// value returns the value of v as held by CustomField.Value
+func (v CustomFieldValue) value() (interface{}, error) {
+	var value interface{}
+	switch v.Type {
+	case Text, TextArea, Regexp, PartialCreditCard, Tagger:
+		value = v.Text
+	case Integer, Lookup:
+		value = json.Number(strconv.FormatInt(v.Integer, 10))
+	case Decimal:
+		value = json.Number(strconv.FormatFloat(v.Decimal, 'f', -1, 64))
+	case Date:
+		value = v.Date.Format(customFieldDateLayout)
+	case Checkbox:
+		value = v.Checkbox
+	case Multiselect:
+		value = v.MultiSelect
+	default:
+		return nil, fmt.Errorf("%q is not a custom field type", v.Type)
+	}
+	if v.Null {
+		return nil, nil
+	}
+	return value, nil
+}
+
+// CustomField returns the custom field with the given id
+func (t Ticket) CustomField(id int64) (CustomField, bool) {
+	for _, cf := range t.CustomFields {
+		if cf.ID == id {
+			return cf, true
+		}
+	}
+	return CustomField{}, false
+}
+
+// CustomFieldString returns the value of the custom field with the given id formatted as text,
+// or an empty string if the ticket does not have the field
+func (t Ticket) CustomFieldString(id int64) string {
+	cf, _ := t.CustomField(id)
+	return cf.String()
+}
+
+// SetCustomField sets the custom field id to value, see NewCustomField for the accepted types.
+// A nil value clears the field.
+func (t *Ticket) SetCustomField(id int64, value interface{}) error {
+	cf, err := NewCustomField(id, value)
+	if err != nil {
+		return err
+	}
+
+	for i := range t.CustomFields {
+		if t.CustomFields[i].ID == id {
+			t.CustomFields[i] = cf
+			return nil
+		}
+	}
+	t.CustomFields = append(t.CustomFields, cf)
+	return nil
+}
@@ -0,0 +1,198 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestCustomFieldTypes(t *testing.T) {
+	date := time.Date(2024, time.March, 5, 0, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		json  string
+		check func(t *testing.T, cf CustomField)
+	}{
+		"text": {`{"id": 1, "value": "some text"}`, func(t *testing.T, cf CustomField) {
+			if v, ok := cf.Text(); !ok || v != "some text" {
+				t.Fatalf("Unexpected text %q", v)
+			}
+		}},
+		"integer": {`{"id": 1, "value": 42}`, func(t *testing.T, cf CustomField) {
+			if v, ok := cf.Integer(); !ok || v != 42 {
+				t.Fatalf("Unexpected integer %d", v)
+			}
+		}},
+		"integer as string": {`{"id": 1, "value": "42"}`, func(t *testing.T, cf CustomField) {
+			if v, ok := cf.Integer(); !ok || v != 42 {
+				t.Fatalf("Unexpected integer %d", v)
+			}
+		}},
+		"decimal": {`{"id": 1, "value": 123.456}`, func(t *testing.T, cf CustomField) {
+			if v, ok := cf.Decimal(); !ok || v != 123.456 {
+				t.Fatalf("Unexpected decimal %f", v)
+			}
+		}},
+		"large integer": {`{"id": 1, "value": 9007199254740993}`, func(t *testing.T, cf CustomField) {
+			if v, ok := cf.Integer(); !ok || v != 9007199254740993 {
+				t.Fatalf("Unexpected integer %d", v)
+			}
+		}},
+		"date": {`{"id": 1, "value": "2024-03-05"}`, func(t *testing.T, cf CustomField) {
+			if v, ok := cf.Date(); !ok || !v.Equal(date) {
+				t.Fatalf("Unexpected date %s", v)
+			}
+		}},
+		"checkbox": {`{"id": 1, "value": true}`, func(t *testing.T, cf CustomField) {
+			if v, ok := cf.Checkbox(); !ok || !v {
+				t.Fatalf("Unexpected checkbox %t", v)
+			}
+		}},
+		"tagger": {`{"id": 1, "value": "priority_high"}`, func(t *testing.T, cf CustomField) {
+			if v, ok := cf.Tagger(); !ok || v != "priority_high" {
+				t.Fatalf("Unexpected tag %q", v)
+			}
+		}},
+		"multiselect": {`{"id": 1, "value": ["red", "blue"]}`, func(t *testing.T, cf CustomField) {
+			if v, ok := cf.MultiSelect(); !ok || !slices.Equal(v, []string{"red", "blue"}) {
+				t.Fatalf("Unexpected tags %v", v)
+			}
+			if cf.String() != "red,blue" {
+				t.Fatalf("Unexpected string %q", cf.String())
+			}
+		}},
+		"lookup": {`{"id": 1, "value": "1234567"}`, func(t *testing.T, cf CustomField) {
+			if v, ok := cf.Lookup(); !ok || v != 1234567 {
+				t.Fatalf("Unexpected lookup %d", v)
+			}
+		}},
+		"null": {`{"id": 1, "value": null}`, func(t *testing.T, cf CustomField) {
+			if !cf.IsNull() || cf.String() != "" {
+				t.Fatalf("Unexpected value %v", cf.Value)
+			}
+			if _, ok := cf.Text(); ok {
+				t.Fatal("Expected no text for a null value")
+			}
+		}},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var cf CustomField
+			if err := json.Unmarshal([]byte(tt.json), &cf); err != nil {
+				t.Fatalf("Failed to unmarshal custom field: %s", err)
+			}
+			tt.check(t, cf)
+
+			// the value is marshaled back as it was received
+			b, err := json.Marshal(cf)
+			if err != nil {
+				t.Fatalf("Failed to marshal custom field: %s", err)
+			}
+			var got, want interface{}
+			_ = json.Unmarshal(b, &got)
+			_ = json.Unmarshal([]byte(tt.json), &want)
+			if string(mustMarshal(t, got)) != string(mustMarshal(t, want)) {
+				t.Fatalf("Custom field %s did not round-trip, got %s", tt.json, b)
+			}
+		})
+	}
+}
+
+func TestSetCustomField(t *testing.T) {
+	var ticket Ticket
+	if err := ticket.SetCustomField(1, 42); err != nil {
+		t.Fatalf("Failed to set custom field: %s", err)
+	}
+	if err := ticket.SetCustomField(2, time.Date(2024, time.March, 5, 0, 0, 0, 0, time.UTC)); err != nil {
+		t.Fatalf("Failed to set custom field: %s", err)
+	}
+	if err := ticket.SetCustomField(1, 1.5); err != nil {
+		t.Fatalf("Failed to set custom field: %s", err)
+	}
+	if err := ticket.SetCustomField(3, struct{}{}); err == nil {
+		t.Fatal("Expected an error for an invalid value")
+	}
+
+	if len(ticket.CustomFields) != 2 {
+		t.Fatalf("Expected the field to be replaced, got %v", ticket.CustomFields)
+	}
+	if ticket.CustomFieldString(1) != "1.5" || ticket.CustomFieldString(2) != "2024-03-05" {
+		t.Fatalf("Unexpected values %v", ticket.CustomFields)
+	}
+
+	b := mustMarshal(t, ticket.CustomFields)
+	if string(b) != `[{"id":1,"value":1.5},{"id":2,"value":"2024-03-05"}]` {
+		t.Fatalf("Unexpected JSON %s", b)
+	}
+}
+
+func TestCustomFieldTyped(t *testing.T) {
+	date := time.Date(2024, time.March, 5, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		json      string
+		fieldType TicketFieldType
+		want      CustomFieldValue
+	}{
+		{`{"id": 1, "value": "some text"}`, TextArea, CustomFieldValue{Type: TextArea, Text: "some text"}},
+		{`{"id": 1, "value": "priority_high"}`, Tagger, CustomFieldValue{Type: Tagger, Text: "priority_high"}},
+		{`{"id": 1, "value": 42}`, Integer, CustomFieldValue{Type: Integer, Integer: 42}},
+		{`{"id": 1, "value": 1234567}`, Lookup, CustomFieldValue{Type: Lookup, Integer: 1234567}},
+		{`{"id": 1, "value": 123.456}`, Decimal, CustomFieldValue{Type: Decimal, Decimal: 123.456}},
+		{`{"id": 1, "value": "2024-03-05"}`, Date, CustomFieldValue{Type: Date, Date: date}},
+		{`{"id": 1, "value": true}`, Checkbox, CustomFieldValue{Type: Checkbox, Checkbox: true}},
+		{`{"id": 1, "value": ["red", "blue"]}`, Multiselect,
+			CustomFieldValue{Type: Multiselect, MultiSelect: []string{"red", "blue"}}},
+		{`{"id": 1, "value": null}`, Integer, CustomFieldValue{Type: Integer, Null: true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.fieldType), func(t *testing.T) {
+			var cf CustomField
+			if err := json.Unmarshal([]byte(tt.json), &cf); err != nil {
+				t.Fatalf("Failed to unmarshal custom field: %s", err)
+			}
+			got, err := cf.Typed(tt.fieldType)
+			if err != nil {
+				t.Fatalf("Failed to type custom field: %s", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("Unexpected value %+v, want %+v", got, tt.want)
+			}
+
+			// the typed value sets the field back to the value it was read from
+			back, err := NewCustomField(cf.ID, got)
+			if err != nil {
+				t.Fatalf("Failed to set custom field: %s", err)
+			}
+			if b := mustMarshal(t, back); string(b) != string(mustMarshal(t, cf)) {
+				t.Fatalf("Custom field %s did not round-trip, got %s", tt.json, b)
+			}
+		})
+	}
+}
+
+func TestCustomFieldTypedErrors(t *testing.T) {
+	cf := CustomField{ID: 1, Value: "not a number"}
+	if _, err := cf.Typed(Integer); err == nil {
+		t.Fatal("Expected an error for a value not matching the field type")
+	}
+	if _, err := cf.Typed(Subject); err == nil {
+		t.Fatal("Expected an error for a system field type")
+	}
+	if _, err := NewCustomField(1, CustomFieldValue{Type: "unknown"}); err == nil {
+		t.Fatal("Expected an error for an unknown field type")
+	}
+}
+
+func mustMarshal(t *testing.T, v interface{}) []byte {
+	t.Helper()
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("Failed to marshal %v: %s", v, err)
+	}
+	return b
+}
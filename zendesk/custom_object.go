@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/JacobPotter/go-zendesk/client"
-	"time"
 )
 
 type CustomObjectRecord struct {
@@ -16,8 +15,8 @@ type CustomObjectRecord struct {
 	CustomObjectFields map[string]interface{} `json:"custom_object_fields" binding:"required"`
 	CreatedByUserID    string                 `json:"created_by_user_id,omitempty"`
 	UpdatedByUserID    string                 `json:"updated_by_user_id,omitempty"`
-	CreatedAt          time.Time              `json:"created_at,omitempty"`
-	UpdatedAt          time.Time              `json:"updated_at,omitempty"`
+	CreatedAt          *Timestamp             `json:"created_at,omitempty"`
+	UpdatedAt          *Timestamp             `json:"updated_at,omitempty"`
 	ExternalID         string                 `json:"external_id,omitempty"`
 }
 
@@ -2,9 +2,7 @@ package zendesk
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"time"
 )
 
 type Scopes map[string]struct {
@@ -59,6 +57,9 @@ type Configuration struct {
 	ViewAccess                   string `json:"view_access"`
 	VoiceAccess                  bool   `json:"voice_access"`
 	VoiceDashboardAccess         bool   `json:"voice_dashboard_access"`
+
+	// Extra keeps the permissions added to the configuration since, see SetKeepExtraFields
+	Extra ExtraFields `json:"-"`
 }
 
 // CustomRole is zendesk CustomRole JSON payload format
@@ -70,8 +71,11 @@ type CustomRole struct {
 	Name            string        `json:"name"`
 	Configuration   Configuration `json:"configuration"`
 	RoleType        int64         `json:"role_type"`
-	CreatedAt       time.Time     `json:"created_at,omitempty"`
-	UpdatedAt       time.Time     `json:"updated_at,omitempty"`
+	CreatedAt       *Timestamp    `json:"created_at,omitempty"`
+	UpdatedAt       *Timestamp    `json:"updated_at,omitempty"`
+
+	// Extra keeps the role fields this struct lacks, see SetKeepExtraFields
+	Extra ExtraFields `json:"-"`
 }
 
 // CustomRoleAPI an interface containing all CustomRole related methods
@@ -97,7 +101,7 @@ func (z *Client) GetCustomRoles(ctx context.Context) ([]CustomRole, error) {
 		return nil, err
 	}
 
-	err = json.Unmarshal(body, &data)
+	err = z.unmarshal(body, &data)
 	if err != nil {
 		return nil, err
 	}
@@ -115,7 +119,7 @@ func (z *Client) GetCustomRole(ctx context.Context, id int64) (CustomRole, error
 		return CustomRole{}, err
 	}
 
-	err = json.Unmarshal(body, &data)
+	err = z.unmarshal(body, &data)
 	if err != nil {
 		return CustomRole{}, err
 	}
@@ -130,11 +134,11 @@ func (z *Client) CreateCustomRole(ctx context.Context, customRole CustomRole) (C
 
 	data.CustomRole = customRole
 
-	body, err := z.Post(ctx, u, data)
+	body, err := z.Post(ctx, u, withExtraFields(data))
 	if err != nil {
 		return CustomRole{}, err
 	}
-	err = json.Unmarshal(body, &result)
+	err = z.unmarshal(body, &result)
 	if err != nil {
 		return CustomRole{}, err
 	}
@@ -147,11 +151,11 @@ func (z *Client) UpdateCustomRole(ctx context.Context, updatedId int64, customRo
 	}
 	u := fmt.Sprintf("/custom_roles/%d.json", updatedId)
 	data.CustomRole = customRole
-	body, err := z.Put(ctx, u, data)
+	body, err := z.Put(ctx, u, withExtraFields(data))
 	if err != nil {
 		return CustomRole{}, err
 	}
-	err = json.Unmarshal(body, &result)
+	err = z.unmarshal(body, &result)
 	if err != nil {
 		return CustomRole{}, err
 	}
@@ -0,0 +1,75 @@
+package zendesk
+
+import (
+	"context"
+	"fmt"
+)
+
+// DeletedTicket is a soft-deleted ticket which can still be restored or deleted permanently
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/tickets/#list-deleted-tickets
+type DeletedTicket struct {
+	ID          int64  `json:"id"`
+	Subject     string `json:"subject"`
+	Description string `json:"description"`
+	Actor       struct {
+		ID   int64  `json:"id"`
+		Name string `json:"name"`
+	} `json:"actor"`
+	DeletedAt     *Timestamp `json:"deleted_at,omitempty"`
+	PreviousState string     `json:"previous_state"`
+}
+
+// DeletedTicketAPI an interface containing the deleted ticket methods
+type DeletedTicketAPI interface {
+	RestoreDeletedTicket(ctx context.Context, ticketID int64) error
+	RestoreDeletedTickets(ctx context.Context, ticketIDs []int64) error
+	DeleteTicketPermanently(ctx context.Context, ticketID int64) (JobStatus, error)
+	DeleteManyTicketsPermanently(ctx context.Context, ticketIDs []int64) ([]BulkJob, error)
+}
+
+// RestoreDeletedTicket restores a soft-deleted ticket
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/tickets/#restore-a-previously-deleted-ticket
+func (z *Client) RestoreDeletedTicket(ctx context.Context, ticketID int64) error {
+	_, err := z.Put(ctx, fmt.Sprintf("/deleted_tickets/%d/restore.json", ticketID), nil)
+	return err
+}
+
+// RestoreDeletedTickets restores soft-deleted tickets, 100 per request
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/tickets/#restore-previously-deleted-tickets-in-bulk
+func (z *Client) RestoreDeletedTickets(ctx context.Context, ticketIDs []int64) error {
+	for offset := 0; offset < len(ticketIDs); offset += bulkLimit {
+		chunk := ticketIDs[offset:min(offset+bulkLimit, len(ticketIDs))]
+		if _, err := z.Put(ctx, "/deleted_tickets/restore_many.json?ids="+joinIDs(chunk), nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteTicketPermanently queues the permanent deletion of a soft-deleted ticket. Use WaitForJob to wait
+// for the deletion to complete. Permanently deleted tickets cannot be restored.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/tickets/#delete-ticket-permanently
+func (z *Client) DeleteTicketPermanently(ctx context.Context, ticketID int64) (JobStatus, error) {
+	body, err := z.DeleteWithBody(ctx, fmt.Sprintf("/deleted_tickets/%d.json", ticketID))
+	if err != nil {
+		return JobStatus{}, err
+	}
+	return decodeJobStatus(body)
+}
+
+// DeleteManyTicketsPermanently queues the permanent deletion of soft-deleted tickets, one job per 100 tickets
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/tickets/#delete-multiple-tickets-permanently
+func (z *Client) DeleteManyTicketsPermanently(ctx context.Context, ticketIDs []int64) ([]BulkJob, error) {
+	return bulk(ticketIDs, ticketIDs, func(chunk []int64) (JobStatus, error) {
+		body, err := z.DeleteWithBody(ctx, "/deleted_tickets/destroy_many.json?ids="+joinIDs(chunk))
+		if err != nil {
+			return JobStatus{}, err
+		}
+		return decodeJobStatus(body)
+	})
+}
@@ -0,0 +1,89 @@
+package zendesk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/JacobPotter/go-zendesk/testhelper"
+)
+
+func TestIterateDeletedTickets(t *testing.T) {
+	mockAPI := testhelper.NewMockAPI(t, http.MethodGet, "deleted_tickets.json")
+	c := NewTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	it := c.IterateDeletedTickets(ctx, &DeletedTicketIteratorOptions{
+		IteratorOptions: IteratorOptions{UseOBP: true},
+		SortBy:          "deleted_at",
+	})
+	tickets, err := it.GetNext()
+	if err != nil {
+		t.Fatalf("Failed to list deleted tickets: %s", err)
+	}
+	if len(tickets) != 2 || tickets[0].Actor.Name != "Jane Agent" || tickets[1].PreviousState != "solved" {
+		t.Fatalf("Unexpected deleted tickets %v", tickets)
+	}
+}
+
+func TestIterateDeletedTicketsRejectsSortByWithCBP(t *testing.T) {
+	mockAPI := testhelper.NewMockAPI(t, http.MethodGet, "deleted_tickets.json")
+	c := NewTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	it := c.IterateDeletedTickets(ctx, &DeletedTicketIteratorOptions{SortBy: "deleted_at"})
+	if _, err := it.GetNext(); err == nil {
+		t.Fatal("Expected an error for sort_by without UseOBP")
+	}
+}
+
+func TestRestoreDeletedTicketsChunks(t *testing.T) {
+	var chunks [][]string
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/deleted_tickets/restore_many.json" {
+			t.Fatalf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		chunks = append(chunks, strings.Split(r.URL.Query().Get("ids"), ","))
+	}))
+	c := NewTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	ids := make([]int64, 150)
+	for i := range ids {
+		ids[i] = int64(1 + i)
+	}
+
+	if err := c.RestoreDeletedTickets(ctx, ids); err != nil {
+		t.Fatalf("Failed to restore tickets: %s", err)
+	}
+	if len(chunks) != 2 || len(chunks[0]) != 100 || len(chunks[1]) != 50 || chunks[1][0] != "101" {
+		t.Fatalf("Unexpected chunks %v", chunks)
+	}
+}
+
+func TestDeleteManyTicketsPermanently(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/deleted_tickets/destroy_many.json" {
+			t.Fatalf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if r.URL.Query().Get("ids") != "1,2" {
+			t.Fatalf("Unexpected ids %s", r.URL.Query().Get("ids"))
+		}
+		_, err := w.Write(testhelper.ReadFixture(t, filepath.Join(http.MethodPut, "update_many_tickets.json")))
+		if err != nil {
+			t.Fatalf("Failed to write fixture: %s", err)
+		}
+	}))
+	c := NewTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	jobs, err := c.DeleteManyTicketsPermanently(ctx, []int64{1, 2})
+	if err != nil {
+		t.Fatalf("Failed to delete tickets: %s", err)
+	}
+	if len(jobs) != 1 || jobs[0].ID == "" || len(jobs[0].IDs) != 2 {
+		t.Fatalf("Unexpected jobs %v", jobs)
+	}
+}
@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/JacobPotter/go-zendesk/client"
-	"time"
 )
 
 // DynamicContentAPI an interface containing all methods associated with zendesk dynamic content
@@ -19,6 +18,20 @@ type DynamicContentAPI interface {
 	GetDynamicContentItemsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[DynamicContentItem]
 	GetDynamicContentItemsOBP(ctx context.Context, opts *OBPOptions) ([]DynamicContentItem, Page, error)
 	GetDynamicContentItemsCBP(ctx context.Context, opts *CBPOptions) ([]DynamicContentItem, client.CursorPaginationMeta, error)
+	IterateDynamicContentItems(ctx context.Context, opts *DynamicContentItemIteratorOptions) *Iterator[DynamicContentItem]
+}
+
+// DynamicContentItemIteratorOptions are the options accepted by IterateDynamicContentItems.
+// The endpoint takes no filters, so only pagination can be configured.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/dynamic_content/#list-items
+type DynamicContentItemIteratorOptions struct {
+	IteratorOptions
+}
+
+// Validate always succeeds as DynamicContentItemIteratorOptions has no filters
+func (o DynamicContentItemIteratorOptions) Validate() error {
+	return nil
 }
 
 // DynamicContentItem is zendesk dynamic content item JSON payload format
@@ -32,23 +45,23 @@ type DynamicContentItem struct {
 	DefaultLocaleID int64                   `json:"default_locale_id"`
 	Outdated        bool                    `json:"outdated,omitempty"`
 	Variants        []DynamicContentVariant `json:"variants"`
-	CreatedAt       time.Time               `json:"created_at,omitempty"`
-	UpdatedAt       time.Time               `json:"updated_at,omitempty"`
+	CreatedAt       *Timestamp              `json:"created_at,omitempty"`
+	UpdatedAt       *Timestamp              `json:"updated_at,omitempty"`
 }
 
 // DynamicContentVariant is zendesk dynamic content variant JSON payload format
 //
 // https://developer.zendesk.com/rest_api/docs/support/dynamic_content#json-format-for-variants
 type DynamicContentVariant struct {
-	ID        int64     `json:"id,omitempty"`
-	URL       string    `json:"url,omitempty"`
-	Content   string    `json:"content"`
-	LocaleID  int64     `json:"locale_id"`
-	Outdated  bool      `json:"outdated,omitempty"`
-	Active    bool      `json:"active"`
-	Default   bool      `json:"default,omitempty"`
-	CreatedAt time.Time `json:"created_at,omitempty"`
-	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	ID        int64      `json:"id,omitempty"`
+	URL       string     `json:"url,omitempty"`
+	Content   string     `json:"content"`
+	LocaleID  int64      `json:"locale_id"`
+	Outdated  bool       `json:"outdated,omitempty"`
+	Active    bool       `json:"active"`
+	Default   bool       `json:"default,omitempty"`
+	CreatedAt *Timestamp `json:"created_at,omitempty"`
+	UpdatedAt *Timestamp `json:"updated_at,omitempty"`
 }
 
 // GetDynamicContentItems fetches dynamic content item list
@@ -93,6 +106,20 @@ func (z *Client) CreateDynamicContentItem(ctx context.Context, item DynamicConte
 	return result.Item, nil
 }
 
+// IterateDynamicContentItems returns an Iterator over all dynamic content items.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/dynamic_content/#list-items
+func (z *Client) IterateDynamicContentItems(
+	ctx context.Context, opts *DynamicContentItemIteratorOptions,
+) *Iterator[DynamicContentItem] {
+	if opts == nil {
+		opts = &DynamicContentItemIteratorOptions{}
+	}
+	return newListIterator(
+		ctx, opts.IteratorOptions, *opts, 0, z.GetDynamicContentItemsOBP, z.GetDynamicContentItemsCBP,
+	)
+}
+
 // GetDynamicContentItem returns the specified dynamic content item.
 //
 // ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/dynamic_content/#show-item
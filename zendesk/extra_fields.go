@@ -0,0 +1,289 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// ExtraFields holds the JSON fields of an item which are not declared on its model, by name. Models which
+// are commonly read, modified and written back, such as Trigger or View, have an Extra field of this type.
+// It is only filled once the client was told to keep them with SetKeepExtraFields, as doing so decodes
+// the responses a second time, and is then written back by updates so that the fields added by Zendesk
+// are not dropped.
+//
+// Declared fields always take precedence over Extra. Set Extra to nil to leave the unknown fields out.
+type ExtraFields map[string]json.RawMessage
+
+var extraFieldsType = reflect.TypeOf(ExtraFields(nil))
+
+// SetKeepExtraFields sets whether the client fills the Extra field of the models which have one with
+// the JSON fields they do not declare. It is off by default.
+func (z *Client) SetKeepExtraFields(keep bool) {
+	z.keepExtraFields = keep
+}
+
+// unmarshal decodes body into v like json.Unmarshal and fills the ExtraFields of the models in v if the
+// client keeps them
+func (z *Client) unmarshal(body []byte, v interface{}) error {
+	if err := json.Unmarshal(body, v); err != nil {
+		return err
+	}
+	if !z.keepExtraFields || !holdsExtraFields(reflect.TypeOf(v)) {
+		return nil
+	}
+	return captureExtraFields(body, reflect.ValueOf(v))
+}
+
+// getData gets url and decodes the response into data, see unmarshal
+func (z *Client) getData(ctx context.Context, url string, data interface{}) error {
+	body, err := z.Get(ctx, url)
+	if err != nil {
+		return err
+	}
+	return z.unmarshal(body, data)
+}
+
+// withExtraFields wraps the request body v so that the ExtraFields of the models in v are written back
+func withExtraFields(v interface{}) json.Marshaler {
+	return extraFieldsBody{v: v}
+}
+
+type extraFieldsBody struct {
+	v interface{}
+}
+
+func (b extraFieldsBody) MarshalJSON() ([]byte, error) {
+	data, err := json.Marshal(b.v)
+	if err != nil || !hasExtraFields(reflect.ValueOf(b.v)) {
+		return data, err
+	}
+	return mergeExtraFields(data, reflect.ValueOf(b.v))
+}
+
+// extraFieldsInfo describes where a struct type holds extra fields
+type extraFieldsInfo struct {
+	// extra is the index of the ExtraFields field, nil if the type has none
+	extra []int
+
+	// known is the set of the JSON names of the declared fields
+	known map[string]bool
+
+	// nested maps the JSON names of the fields whose types hold extra fields to their index
+	nested map[string][]int
+}
+
+// extraFieldsInfos caches the extraFieldsInfo of the struct types, by type
+var extraFieldsInfos sync.Map
+
+// structExtraFields returns the extraFieldsInfo of the struct type t
+func structExtraFields(t reflect.Type) *extraFieldsInfo {
+	if info, ok := extraFieldsInfos.Load(t); ok {
+		return info.(*extraFieldsInfo)
+	}
+	info, _ := extraFieldsInfos.LoadOrStore(t, newExtraFieldsInfo(t, map[reflect.Type]*extraFieldsInfo{}))
+	return info.(*extraFieldsInfo)
+}
+
+// newExtraFieldsInfo walks the fields of the struct type t. visiting holds the types being walked, so
+// that recursive types terminate.
+func newExtraFieldsInfo(t reflect.Type, visiting map[reflect.Type]*extraFieldsInfo) *extraFieldsInfo {
+	if info, ok := visiting[t]; ok {
+		return info
+	}
+	info := &extraFieldsInfo{known: map[string]bool{}, nested: map[string][]int{}}
+	visiting[t] = info
+	info.walk(t, nil, visiting)
+	return info
+}
+
+func (info *extraFieldsInfo) walk(t reflect.Type, index []int, visiting map[reflect.Type]*extraFieldsInfo) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		fieldIndex := append(append([]int{}, index...), i)
+
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			if field.Type == extraFieldsType {
+				info.extra = fieldIndex
+			}
+			continue
+		}
+		if field.Anonymous && name == "" && field.Type.Kind() == reflect.Struct {
+			info.walk(field.Type, fieldIndex, visiting)
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		info.known[name] = true
+		if holdsExtraFieldsVisiting(field.Type, visiting) {
+			info.nested[name] = fieldIndex
+		}
+	}
+}
+
+// holdsExtraFields reports whether values of type t can hold ExtraFields
+func holdsExtraFields(t reflect.Type) bool {
+	return holdsExtraFieldsVisiting(t, nil)
+}
+
+func holdsExtraFieldsVisiting(t reflect.Type, visiting map[reflect.Type]*extraFieldsInfo) bool {
+	for t.Kind() == reflect.Pointer || t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return false
+	}
+
+	var info *extraFieldsInfo
+	if cached, ok := extraFieldsInfos.Load(t); ok {
+		info = cached.(*extraFieldsInfo)
+	} else if visiting != nil {
+		info = newExtraFieldsInfo(t, visiting)
+	} else {
+		info = structExtraFields(t)
+	}
+	return info.extra != nil || len(info.nested) > 0
+}
+
+// captureExtraFields fills the ExtraFields of the models in v with the fields of data they do not declare
+func captureExtraFields(data json.RawMessage, v reflect.Value) error {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			return nil
+		}
+		return captureExtraFields(data, v.Elem())
+	case reflect.Slice, reflect.Array:
+		var items []json.RawMessage
+		if err := json.Unmarshal(data, &items); err != nil {
+			return err
+		}
+		for i := 0; i < len(items) && i < v.Len(); i++ {
+			if err := captureExtraFields(items[i], v.Index(i)); err != nil {
+				return err
+			}
+		}
+	case reflect.Struct:
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(data, &fields); err != nil {
+			return err
+		}
+
+		info := structExtraFields(v.Type())
+		for name, index := range info.nested {
+			if value, ok := fields[name]; ok {
+				if err := captureExtraFields(value, v.FieldByIndex(index)); err != nil {
+					return err
+				}
+			}
+		}
+		if info.extra == nil {
+			return nil
+		}
+
+		var extra ExtraFields
+		for name, value := range fields {
+			if !info.known[name] {
+				if extra == nil {
+					extra = ExtraFields{}
+				}
+				extra[name] = value
+			}
+		}
+		v.FieldByIndex(info.extra).Set(reflect.ValueOf(extra))
+	}
+	return nil
+}
+
+// hasExtraFields reports whether any model in v has ExtraFields to write back
+func hasExtraFields(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		return !v.IsNil() && hasExtraFields(v.Elem())
+	case reflect.Slice, reflect.Array:
+		if !holdsExtraFields(v.Type()) {
+			return false
+		}
+		for i := 0; i < v.Len(); i++ {
+			if hasExtraFields(v.Index(i)) {
+				return true
+			}
+		}
+	case reflect.Struct:
+		info := structExtraFields(v.Type())
+		if info.extra != nil && v.FieldByIndex(info.extra).Len() > 0 {
+			return true
+		}
+		for _, index := range info.nested {
+			if hasExtraFields(v.FieldByIndex(index)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// mergeExtraFields adds the ExtraFields of the models in v to data, the JSON encoding of v, where they
+// are not already set
+func mergeExtraFields(data json.RawMessage, v reflect.Value) (json.RawMessage, error) {
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			return data, nil
+		}
+		return mergeExtraFields(data, v.Elem())
+	case reflect.Slice, reflect.Array:
+		if !hasExtraFields(v) {
+			return data, nil
+		}
+		var items []json.RawMessage
+		if err := json.Unmarshal(data, &items); err != nil {
+			return nil, err
+		}
+		for i := 0; i < len(items) && i < v.Len(); i++ {
+			item, err := mergeExtraFields(items[i], v.Index(i))
+			if err != nil {
+				return nil, err
+			}
+			items[i] = item
+		}
+		return json.Marshal(items)
+	case reflect.Struct:
+		if !hasExtraFields(v) {
+			return data, nil
+		}
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(data, &fields); err != nil {
+			return nil, err
+		}
+
+		info := structExtraFields(v.Type())
+		for name, index := range info.nested {
+			value, ok := fields[name]
+			if !ok {
+				continue
+			}
+			value, err := mergeExtraFields(value, v.FieldByIndex(index))
+			if err != nil {
+				return nil, err
+			}
+			fields[name] = value
+		}
+		if info.extra != nil {
+			for name, value := range v.FieldByIndex(info.extra).Interface().(ExtraFields) {
+				if _, ok := fields[name]; !ok {
+					fields[name] = value
+				}
+			}
+		}
+		return json.Marshal(fields)
+	}
+	return data, nil
+}
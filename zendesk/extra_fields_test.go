@@ -0,0 +1,163 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/JacobPotter/go-zendesk/testhelper"
+)
+
+func TestExtraFieldsRoundTrip(t *testing.T) {
+	data := `{"id":1,"title":"Notify requester","active":true,"conditions":{"all":[],"any":[]},"actions":[],` +
+		`"raw_title":"{{dc.notify}}","default":false}`
+
+	var trigger Trigger
+	if err := (&Client{}).unmarshal([]byte(data), &trigger); err != nil || trigger.Extra != nil {
+		t.Fatalf("Expected no extra fields unless the client keeps them, got %v: %v", trigger.Extra, err)
+	}
+
+	z := &Client{}
+	z.SetKeepExtraFields(true)
+	if err := z.unmarshal([]byte(data), &trigger); err != nil {
+		t.Fatalf("Failed to unmarshal trigger: %s", err)
+	}
+	if trigger.ID != 1 || trigger.Title != "Notify requester" {
+		t.Fatalf("Declared fields were not decoded: %v", trigger)
+	}
+	if len(trigger.Extra) != 2 || string(trigger.Extra["raw_title"]) != `"{{dc.notify}}"` {
+		t.Fatalf("Unexpected extra fields: %v", trigger.Extra)
+	}
+
+	trigger.Title = "Notify the requester"
+	b, err := json.Marshal(withExtraFields(trigger))
+	if err != nil {
+		t.Fatalf("Failed to marshal trigger: %s", err)
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal(b, &fields); err != nil {
+		t.Fatal(err)
+	}
+	if fields["raw_title"] != "{{dc.notify}}" || fields["default"] != false {
+		t.Fatalf("Extra fields were not written back: %s", b)
+	}
+	if fields["title"] != "Notify the requester" {
+		t.Fatalf("Declared fields must take precedence over extra fields: %s", b)
+	}
+
+	trigger.Extra = nil
+	b, _ = json.Marshal(withExtraFields(trigger))
+	if strings.Contains(string(b), "raw_title") {
+		t.Fatalf("Extra fields were written after being cleared: %s", b)
+	}
+}
+
+func TestExtraFieldsNested(t *testing.T) {
+	data := `{"custom_roles":[{"id":1,"name":"Agent","configuration":{}},{"id":2,"name":"Auditor",` +
+		`"configuration":{"chat_access":true,"manage_macro_content_suggestions":true},` +
+		`"role_type":0,"team_member_count":1,"assignable":false}]}`
+
+	var result struct {
+		CustomRoles []CustomRole `json:"custom_roles"`
+	}
+	z := &Client{}
+	z.SetKeepExtraFields(true)
+	if err := z.unmarshal([]byte(data), &result); err != nil {
+		t.Fatalf("Failed to unmarshal custom roles: %s", err)
+	}
+	if result.CustomRoles[0].Extra != nil || result.CustomRoles[0].Configuration.Extra != nil {
+		t.Fatalf("Unexpected extra fields for a role without any: %v", result.CustomRoles[0])
+	}
+	role := result.CustomRoles[1]
+	if !role.Configuration.ChatAccess {
+		t.Fatal("Declared configuration fields were not decoded")
+	}
+	if _, ok := role.Configuration.Extra["manage_macro_content_suggestions"]; !ok || len(role.Configuration.Extra) != 1 {
+		t.Fatalf("Unexpected extra configuration fields: %v", role.Configuration.Extra)
+	}
+	if _, ok := role.Extra["assignable"]; !ok || len(role.Extra) != 1 {
+		t.Fatalf("Unexpected extra custom role fields: %v", role.Extra)
+	}
+
+	b, err := json.Marshal(withExtraFields(struct {
+		CustomRole CustomRole `json:"custom_role"`
+	}{role}))
+	if err != nil {
+		t.Fatalf("Failed to marshal custom role: %s", err)
+	}
+	if !strings.Contains(string(b), `"manage_macro_content_suggestions":true`) || !strings.Contains(string(b), `"assignable":false`) {
+		t.Fatalf("Extra fields were not written back: %s", b)
+	}
+}
+
+func TestUpdateViewKeepsExtraFields(t *testing.T) {
+	fixture := testhelper.ReadFixture(t, filepath.Join(http.MethodGet, "view.json"))
+	var fixtureView struct {
+		View map[string]json.RawMessage `json:"view"`
+	}
+	if err := json.Unmarshal(fixture, &fixtureView); err != nil {
+		t.Fatalf("Failed to decode fixture: %s", err)
+	}
+
+	var sent map[string]json.RawMessage
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			var data struct {
+				View map[string]json.RawMessage `json:"view"`
+			}
+			body, _ := io.ReadAll(r.Body)
+			if err := json.Unmarshal(body, &data); err != nil {
+				t.Errorf("Failed to decode update %s: %s", body, err)
+			}
+			sent = data.View
+		}
+		_, _ = w.Write(fixture)
+	}))
+	defer mockAPI.Close()
+	c := NewTestClient(mockAPI)
+	c.SetKeepExtraFields(true)
+
+	view, err := c.GetView(ctx, 360002440594)
+	if err != nil {
+		t.Fatalf("Failed to get view: %s", err)
+	}
+	// watchable is returned by Zendesk but not declared on View
+	if string(view.Extra["watchable"]) != string(fixtureView.View["watchable"]) {
+		t.Fatalf("Expected the unknown watchable field to be captured, got %v", view.Extra)
+	}
+
+	view.Title = "Updated"
+	if _, err := c.UpdateView(ctx, view.ID, view); err != nil {
+		t.Fatalf("Failed to update view: %s", err)
+	}
+	if string(sent["watchable"]) != string(fixtureView.View["watchable"]) {
+		t.Fatalf("Expected watchable %s to be sent back unchanged, got %s", fixtureView.View["watchable"], sent["watchable"])
+	}
+	if string(sent["title"]) != `"Updated"` {
+		t.Fatalf("Expected the updated title to be sent, got %s", sent["title"])
+	}
+}
+
+func TestGeneratedListKeepsExtraFields(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"triggers":[{"id":1,"title":"Notify requester","raw_title":"{{dc.notify}}"}],` +
+			`"meta":{"has_more":false}}`))
+	}))
+	defer mockAPI.Close()
+	c := NewTestClient(mockAPI)
+
+	triggers, _, err := c.GetTriggersCBP(ctx, nil)
+	if err != nil || triggers[0].Extra != nil {
+		t.Fatalf("Expected no extra fields by default, got %v: %v", triggers, err)
+	}
+
+	c.SetKeepExtraFields(true)
+	triggers, _, err = c.GetTriggersCBP(ctx, nil)
+	if err != nil || string(triggers[0].Extra["raw_title"]) != `"{{dc.notify}}"` {
+		t.Fatalf("Unexpected extra fields %v: %v", triggers, err)
+	}
+}
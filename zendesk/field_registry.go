@@ -0,0 +1,384 @@
+package zendesk
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// FieldObject is the kind of object a custom field belongs to
+type FieldObject string
+
+// Objects with custom fields
+const (
+	FieldObjectTicket       FieldObject = "ticket"
+	FieldObjectUser         FieldObject = "user"
+	FieldObjectOrganization FieldObject = "organization"
+)
+
+// FieldNotFoundError is returned by FieldRegistry when a field, or an option of a field, does not exist
+type FieldNotFoundError struct {
+	Object FieldObject
+	Field  string
+
+	// Option is the option of Field which was not found, if any
+	Option string
+}
+
+func (e *FieldNotFoundError) Error() string {
+	if e.Option != "" {
+		return fmt.Sprintf("%s field %q has no option %q", e.Object, e.Field, e.Option)
+	}
+	return fmt.Sprintf("%s field %q not found", e.Object, e.Field)
+}
+
+// FieldRegistryOptions configures a FieldRegistry
+type FieldRegistryOptions struct {
+	// MaxAge is how long the fields are cached before being fetched again.
+	// The default of 0 caches them until Refresh is called.
+	MaxAge time.Duration
+}
+
+// FieldRegistry resolves the custom fields of tickets, users and organizations by name, so that
+// code does not depend on field ids which differ between accounts, e.g. a sandbox and production.
+//
+// Ticket fields are found by title, raw title or tag. User and organization fields are found by key,
+// title, raw title or tag. Titles are matched case-insensitively. The options of drop-down and
+// multiselect fields are found by name or raw name and converted to and from their tag values.
+//
+// The fields are fetched on first use and cached; FieldRegistry is safe for concurrent use.
+type FieldRegistry struct {
+	z      *Client
+	maxAge time.Duration
+
+	mu       sync.Mutex
+	loadedAt time.Time
+
+	ticketFields       []TicketField
+	userFields         []UserField
+	organizationFields []OrganizationField
+	fields             map[FieldObject][]registryField
+}
+
+// registryField is the part of a ticket, user or organization field used to resolve it
+type registryField struct {
+	ID      int64
+	Key     string
+	Type    string
+	Titles  []string
+	Tag     string
+	Options []CustomFieldOption
+}
+
+// NewFieldRegistry returns a FieldRegistry fetching fields with z
+func (z *Client) NewFieldRegistry(opts *FieldRegistryOptions) *FieldRegistry {
+	r := &FieldRegistry{z: z}
+	if opts != nil {
+		r.maxAge = opts.MaxAge
+	}
+	return r
+}
+
+// Refresh fetches the fields again, e.g. after fields or options were added
+func (r *FieldRegistry) Refresh(ctx context.Context) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.load(ctx)
+}
+
+// TicketField returns the ticket field with the given title or tag
+func (r *FieldRegistry) TicketField(ctx context.Context, name string) (TicketField, error) {
+	var field TicketField
+	err := r.lookup(ctx, FieldObjectTicket, name, func(i int) {
+		field = r.ticketFields[i]
+	})
+	return field, err
+}
+
+// UserField returns the user field with the given key, title or tag
+func (r *FieldRegistry) UserField(ctx context.Context, name string) (UserField, error) {
+	var field UserField
+	err := r.lookup(ctx, FieldObjectUser, name, func(i int) {
+		field = r.userFields[i]
+	})
+	return field, err
+}
+
+// OrganizationField returns the organization field with the given key, title or tag
+func (r *FieldRegistry) OrganizationField(ctx context.Context, name string) (OrganizationField, error) {
+	var field OrganizationField
+	err := r.lookup(ctx, FieldObjectOrganization, name, func(i int) {
+		field = r.organizationFields[i]
+	})
+	return field, err
+}
+
+// FieldID returns the id of the field of object with the given name
+func (r *FieldRegistry) FieldID(ctx context.Context, object FieldObject, name string) (int64, error) {
+	f, err := r.field(ctx, object, name)
+	return f.ID, err
+}
+
+// OptionTag returns the tag value of the option of a drop-down or multiselect field with the given name
+func (r *FieldRegistry) OptionTag(ctx context.Context, object FieldObject, field, option string) (string, error) {
+	f, err := r.field(ctx, object, field)
+	if err != nil {
+		return "", err
+	}
+	o, ok := f.option(option)
+	if !ok {
+		return "", &FieldNotFoundError{Object: object, Field: field, Option: option}
+	}
+	return o.Value, nil
+}
+
+// OptionName returns the name of the option of a drop-down or multiselect field with the given tag value
+func (r *FieldRegistry) OptionName(ctx context.Context, object FieldObject, field, tag string) (string, error) {
+	f, err := r.field(ctx, object, field)
+	if err != nil {
+		return "", err
+	}
+	for _, o := range f.Options {
+		if o.Value == tag {
+			return o.Name, nil
+		}
+	}
+	return "", &FieldNotFoundError{Object: object, Field: field, Option: tag}
+}
+
+// TicketValue returns the value of the custom field with the given name. The value is null if the
+// ticket does not have the field.
+func (r *FieldRegistry) TicketValue(ctx context.Context, ticket Ticket, name string) (CustomField, error) {
+	f, err := r.field(ctx, FieldObjectTicket, name)
+	if err != nil {
+		return CustomField{}, err
+	}
+	if cf, ok := ticket.CustomField(f.ID); ok {
+		return cf, nil
+	}
+	return CustomField{ID: f.ID}, nil
+}
+
+// TicketTypedValue returns the value of the custom field with the given name typed after the type of
+// the field, see CustomField.Typed
+func (r *FieldRegistry) TicketTypedValue(ctx context.Context, ticket Ticket, name string) (CustomFieldValue, error) {
+	f, err := r.field(ctx, FieldObjectTicket, name)
+	if err != nil {
+		return CustomFieldValue{}, err
+	}
+	cf, ok := ticket.CustomField(f.ID)
+	if !ok {
+		cf = CustomField{ID: f.ID}
+	}
+	return cf.Typed(TicketFieldType(f.Type))
+}
+
+// SetTicketValue sets the custom field with the given name, see Ticket.SetCustomField for the accepted
+// values. The options of drop-down and multiselect fields can be given by name or tag.
+func (r *FieldRegistry) SetTicketValue(ctx context.Context, ticket *Ticket, name string, value interface{}) error {
+	f, err := r.field(ctx, FieldObjectTicket, name)
+	if err != nil {
+		return err
+	}
+	value, err = f.optionTags(FieldObjectTicket, name, value)
+	if err != nil {
+		return err
+	}
+	return ticket.SetCustomField(f.ID, value)
+}
+
+// UserValue returns the value of the user field with the given name, or nil if it is not set
+func (r *FieldRegistry) UserValue(ctx context.Context, user User, name string) (interface{}, error) {
+	f, err := r.field(ctx, FieldObjectUser, name)
+	if err != nil {
+		return nil, err
+	}
+	return user.UserFields[f.Key], nil
+}
+
+// SetUserValue sets the user field with the given name. The options of drop-down and multiselect
+// fields can be given by name or tag, and dates as a time.Time.
+func (r *FieldRegistry) SetUserValue(ctx context.Context, user *User, name string, value interface{}) error {
+	f, err := r.field(ctx, FieldObjectUser, name)
+	if err != nil {
+		return err
+	}
+	value, err = f.fieldValue(FieldObjectUser, name, value)
+	if err != nil {
+		return err
+	}
+	if user.UserFields == nil {
+		user.UserFields = UserFields{}
+	}
+	user.UserFields[f.Key] = value
+	return nil
+}
+
+// OrganizationValue returns the value of the organization field with the given name, or nil if it is not set
+func (r *FieldRegistry) OrganizationValue(ctx context.Context, org Organization, name string) (interface{}, error) {
+	f, err := r.field(ctx, FieldObjectOrganization, name)
+	if err != nil {
+		return nil, err
+	}
+	return org.OrganizationFields[f.Key], nil
+}
+
+// SetOrganizationValue sets the organization field with the given name like SetUserValue
+func (r *FieldRegistry) SetOrganizationValue(
+	ctx context.Context, org *Organization, name string, value interface{},
+) error {
+	f, err := r.field(ctx, FieldObjectOrganization, name)
+	if err != nil {
+		return err
+	}
+	value, err = f.fieldValue(FieldObjectOrganization, name, value)
+	if err != nil {
+		return err
+	}
+	if org.OrganizationFields == nil {
+		org.OrganizationFields = map[string]interface{}{}
+	}
+	org.OrganizationFields[f.Key] = value
+	return nil
+}
+
+func (r *FieldRegistry) field(ctx context.Context, object FieldObject, name string) (registryField, error) {
+	var field registryField
+	err := r.lookup(ctx, object, name, func(i int) {
+		field = r.fields[object][i]
+	})
+	return field, err
+}
+
+// lookup calls found with the index of the field of object with the given name, loading the fields if
+// needed. found is called with r.mu held so that the fields are not refreshed meanwhile.
+func (r *FieldRegistry) lookup(ctx context.Context, object FieldObject, name string, found func(i int)) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.fields == nil || (r.maxAge > 0 && time.Since(r.loadedAt) > r.maxAge) {
+		if err := r.load(ctx); err != nil {
+			return err
+		}
+	}
+
+	switch object {
+	case FieldObjectTicket, FieldObjectUser, FieldObjectOrganization:
+	default:
+		return fmt.Errorf("%q is not an object with custom fields", object)
+	}
+	for i, f := range r.fields[object] {
+		if f.matches(name) {
+			found(i)
+			return nil
+		}
+	}
+	return &FieldNotFoundError{Object: object, Field: name}
+}
+
+// load fetches all the fields, r.mu must be held
+func (r *FieldRegistry) load(ctx context.Context) error {
+	ticketFields, err := collect(r.z.IterateTicketFields(ctx, nil))
+	if err != nil {
+		return err
+	}
+	userFields, err := collect(r.z.IterateUserFields(ctx, nil))
+	if err != nil {
+		return err
+	}
+	organizationFields, err := collect(r.z.IterateOrganizationFields(ctx, nil))
+	if err != nil {
+		return err
+	}
+
+	fields := map[FieldObject][]registryField{}
+	for _, f := range ticketFields {
+		fields[FieldObjectTicket] = append(fields[FieldObjectTicket], registryField{
+			ID: f.ID, Type: f.Type, Titles: []string{f.Title, f.RawTitle}, Tag: f.Tag, Options: f.CustomFieldOptions,
+		})
+	}
+	for _, f := range userFields {
+		fields[FieldObjectUser] = append(fields[FieldObjectUser], registryField{
+			ID: f.ID, Key: f.Key, Type: f.Type, Titles: []string{f.Title, f.RawTitle}, Tag: f.Tag,
+			Options: f.CustomFieldOptions,
+		})
+	}
+	for _, f := range organizationFields {
+		fields[FieldObjectOrganization] = append(fields[FieldObjectOrganization], registryField{
+			ID: f.ID, Key: f.Key, Type: f.Type, Titles: []string{f.Title, f.RawTitle}, Tag: f.Tag,
+			Options: f.CustomFieldOptions,
+		})
+	}
+
+	r.ticketFields, r.userFields, r.organizationFields = ticketFields, userFields, organizationFields
+	r.fields = fields
+	r.loadedAt = time.Now()
+	return nil
+}
+
+func (f registryField) matches(name string) bool {
+	if name == "" {
+		return false
+	}
+	if f.Key == name || f.Tag == name {
+		return true
+	}
+	for _, title := range f.Titles {
+		if strings.EqualFold(title, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// option returns the option with the given name, raw name or tag value
+func (f registryField) option(name string) (CustomFieldOption, bool) {
+	for _, o := range f.Options {
+		if o.Value == name || strings.EqualFold(o.Name, name) || strings.EqualFold(o.RawName, name) {
+			return o, true
+		}
+	}
+	return CustomFieldOption{}, false
+}
+
+// optionTags replaces the option names in value by their tags for fields with options
+func (f registryField) optionTags(object FieldObject, name string, value interface{}) (interface{}, error) {
+	if len(f.Options) == 0 {
+		return value, nil
+	}
+
+	switch v := value.(type) {
+	case string:
+		o, ok := f.option(v)
+		if !ok {
+			return nil, &FieldNotFoundError{Object: object, Field: name, Option: v}
+		}
+		return o.Value, nil
+	case []string:
+		tags := make([]string, len(v))
+		for i, s := range v {
+			o, ok := f.option(s)
+			if !ok {
+				return nil, &FieldNotFoundError{Object: object, Field: name, Option: s}
+			}
+			tags[i] = o.Value
+		}
+		return tags, nil
+	}
+	return value, nil
+}
+
+// fieldValue converts value to the representation of user and organization fields
+func (f registryField) fieldValue(object FieldObject, name string, value interface{}) (interface{}, error) {
+	value, err := f.optionTags(object, name, value)
+	if err != nil {
+		return nil, err
+	}
+	cf, err := NewCustomField(f.ID, value)
+	if err != nil {
+		return nil, err
+	}
+	return cf.Value, nil
+}
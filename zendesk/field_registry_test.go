@@ -0,0 +1,108 @@
+package zendesk
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/JacobPotter/go-zendesk/testhelper"
+)
+
+func newFieldRegistryServer(t *testing.T, requests *int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests++
+		// the deprecated CommonOptions sent active=false to the field listings
+		if r.URL.Query().Has("active") {
+			t.Errorf("Unexpected active parameter in %s", r.URL)
+		}
+		fixture := strings.TrimPrefix(r.URL.Path, "/")
+		_, err := w.Write(testhelper.ReadFixture(t, filepath.Join(http.MethodGet, fixture)))
+		if err != nil {
+			t.Fatalf("Failed to write fixture: %s", err)
+		}
+	}))
+}
+
+func TestFieldRegistryTicketFields(t *testing.T) {
+	requests := 0
+	mockAPI := newFieldRegistryServer(t, &requests)
+	c := NewTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	r := c.NewFieldRegistry(nil)
+
+	id, err := r.FieldID(ctx, FieldObjectTicket, "tagger field")
+	if err != nil || id != 360011759674 {
+		t.Fatalf("Unexpected id %d: %v", id, err)
+	}
+
+	var ticket Ticket
+	if err := r.SetTicketValue(ctx, &ticket, "Tagger Field", "Option 2"); err != nil {
+		t.Fatalf("Failed to set ticket field: %s", err)
+	}
+	if err := r.SetTicketValue(ctx, &ticket, "Integer Field", 7); err != nil {
+		t.Fatalf("Failed to set ticket field: %s", err)
+	}
+	cf, err := r.TicketValue(ctx, ticket, "Tagger Field")
+	if tag, _ := cf.Tagger(); err != nil || tag != "opt2" {
+		t.Fatalf("Unexpected value %v: %v", cf, err)
+	}
+	if name, err := r.OptionName(ctx, FieldObjectTicket, "Tagger Field", "opt2"); err != nil || name != "Option 2" {
+		t.Fatalf("Unexpected option name %q: %v", name, err)
+	}
+	if cf, _ := r.TicketValue(ctx, ticket, "Decimal Field"); !cf.IsNull() || cf.ID != 360011747974 {
+		t.Fatalf("Expected a null value for an unset field, got %v", cf)
+	}
+	if v, err := r.TicketTypedValue(ctx, ticket, "Integer Field"); err != nil || v.Type != Integer || v.Integer != 7 {
+		t.Fatalf("Unexpected typed value %+v: %v", v, err)
+	}
+	if v, err := r.TicketTypedValue(ctx, ticket, "Decimal Field"); err != nil || v.Type != Decimal || !v.Null {
+		t.Fatalf("Expected a null typed value for an unset field, got %+v: %v", v, err)
+	}
+
+	var notFound *FieldNotFoundError
+	if err := r.SetTicketValue(ctx, &ticket, "Tagger Field", "Option 3"); !errors.As(err, &notFound) || notFound.Option != "Option 3" {
+		t.Fatalf("Expected an unknown option error, got %v", err)
+	}
+	if _, err := r.TicketField(ctx, "Missing Field"); !errors.As(err, &notFound) {
+		t.Fatalf("Expected a field not found error, got %v", err)
+	}
+
+	if requests != 3 {
+		t.Fatalf("Expected the fields to be fetched once, got %d requests", requests)
+	}
+	if err := r.Refresh(ctx); err != nil {
+		t.Fatalf("Failed to refresh: %s", err)
+	}
+	if requests != 6 {
+		t.Fatalf("Expected the fields to be fetched again, got %d requests", requests)
+	}
+}
+
+func TestFieldRegistryUserAndOrganizationFields(t *testing.T) {
+	requests := 0
+	mockAPI := newFieldRegistryServer(t, &requests)
+	c := NewTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	r := c.NewFieldRegistry(nil)
+
+	var user User
+	if err := r.SetUserValue(ctx, &user, "Custom Field 1", "text"); err != nil {
+		t.Fatalf("Failed to set user field: %s", err)
+	}
+	if v, err := r.UserValue(ctx, user, "custom_field_1"); err != nil || v != "text" {
+		t.Fatalf("Unexpected user field value %v: %v", v, err)
+	}
+
+	var org Organization
+	if err := r.SetOrganizationValue(ctx, &org, "External Test ID", "A-1"); err != nil {
+		t.Fatalf("Failed to set organization field: %s", err)
+	}
+	if len(org.OrganizationFields) != 1 || org.OrganizationFields["test_external_id"] != "A-1" {
+		t.Fatalf("Unexpected organization fields %v", org.OrganizationFields)
+	}
+}
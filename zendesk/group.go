@@ -5,21 +5,20 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/JacobPotter/go-zendesk/client"
-	"time"
 )
 
 // Group is struct for group payload
 // https://developer.zendesk.com/rest_api/docs/support/groups
 type Group struct {
-	ID          int64     `json:"id,omitempty"`
-	URL         string    `json:"url,omitempty"`
-	Name        string    `json:"name"`
-	Default     bool      `json:"default,omitempty"`
-	Deleted     bool      `json:"deleted,omitempty"`
-	IsPublic    bool      `json:"is_public"`
-	Description string    `json:"description,omitempty"`
-	CreatedAt   time.Time `json:"created_at,omitempty"`
-	UpdatedAt   time.Time `json:"updated_at,omitempty"`
+	ID          int64      `json:"id,omitempty"`
+	URL         string     `json:"url,omitempty"`
+	Name        string     `json:"name"`
+	Default     bool       `json:"default,omitempty"`
+	Deleted     bool       `json:"deleted,omitempty"`
+	IsPublic    bool       `json:"is_public"`
+	Description string     `json:"description,omitempty"`
+	CreatedAt   *Timestamp `json:"created_at,omitempty"`
+	UpdatedAt   *Timestamp `json:"updated_at,omitempty"`
 }
 
 // GroupListOptions is options for GetGroups
@@ -38,6 +37,7 @@ type GroupAPI interface {
 	GetGroup(ctx context.Context, groupID int64) (Group, error)
 	CreateGroup(ctx context.Context, group Group) (Group, error)
 	UpdateGroup(ctx context.Context, groupID int64, group Group) (Group, error)
+	PatchGroup(ctx context.Context, groupID int64, patch GroupPatch) (Group, error)
 	DeleteGroup(ctx context.Context, groupID int64) error
 }
 
@@ -71,77 +71,23 @@ func (z *Client) GetGroups(ctx context.Context, opts *GroupListOptions) ([]Group
 	return data.Groups, data.Page, nil
 }
 
-// CreateGroup creates new group
-// https://developer.zendesk.com/rest_api/docs/support/groups#create-group
-func (z *Client) CreateGroup(ctx context.Context, group Group) (Group, error) {
-	var data, result struct {
-		Group Group `json:"group"`
-	}
-	data.Group = group
-
-	body, err := z.Post(ctx, "/groups.json", data)
-	if err != nil {
-		return Group{}, err
-	}
-
-	err = json.Unmarshal(body, &result)
-	if err != nil {
-		return Group{}, err
-	}
-	return result.Group, nil
-}
-
-// GetGroup gets a specified group
-// ref: https://developer.zendesk.com/rest_api/docs/support/groups#show-group
-func (z *Client) GetGroup(ctx context.Context, groupID int64) (Group, error) {
-	var result struct {
-		Group Group `json:"group"`
-	}
-
-	body, err := z.Get(ctx, fmt.Sprintf("/groups/%d.json", groupID))
-
-	if err != nil {
-		return Group{}, err
-	}
-
-	err = json.Unmarshal(body, &result)
-	if err != nil {
-		return Group{}, err
-	}
-
-	return result.Group, err
+// GroupPatch is a partial update of a group. Only the fields which are set are sent, and fields set to
+// Null are cleared.
+type GroupPatch struct {
+	Name        Nullable[string] `json:"name"`
+	Description Nullable[string] `json:"description"`
+	Default     Nullable[bool]   `json:"default"`
+	IsPublic    Nullable[bool]   `json:"is_public"`
 }
 
-// UpdateGroup updates a group with the specified group
-// ref: https://developer.zendesk.com/rest_api/docs/support/groups#update-group
-func (z *Client) UpdateGroup(ctx context.Context, groupID int64, group Group) (Group, error) {
-	var result, data struct {
-		Group Group `json:"group"`
-	}
-	data.Group = group
-
-	body, err := z.Put(ctx, fmt.Sprintf("/groups/%d.json", groupID), data)
-
-	if err != nil {
-		return Group{}, err
-	}
-
-	err = json.Unmarshal(body, &result)
-	if err != nil {
-		return Group{}, err
-	}
-
-	return result.Group, err
+// MarshalJSON is marshaller for GroupPatch, which leaves out the fields which are not set
+func (p GroupPatch) MarshalJSON() ([]byte, error) {
+	return marshalPatch(p)
 }
 
-// DeleteGroup deletes the specified group
-// ref: https://developer.zendesk.com/rest_api/docs/support/groups#delete-group
-func (z *Client) DeleteGroup(ctx context.Context, groupID int64) error {
-	err := z.Delete(ctx, fmt.Sprintf("/groups/%d.json", groupID))
-
-	if err != nil {
-		return err
-	}
-
-	return nil
+// PatchGroup updates the fields set in patch. Unlike UpdateGroup, fields can be set to their zero value.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/groups/groups/#update-group
+func (z *Client) PatchGroup(ctx context.Context, groupID int64, patch GroupPatch) (Group, error) {
+	return putPatch[Group](z, ctx, fmt.Sprintf("/groups/%d.json", groupID), "group", patch)
 }
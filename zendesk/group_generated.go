@@ -1,17 +1,20 @@
 // Code generated by Script. DO NOT EDIT.
-// Source: script/codegen/main.go
+// Source: script/codegen/resources.yaml
 //
 // Generated by this command:
 //
-//	go run script/codegen/main.go
+//	go run ./script/codegen
 
 package zendesk
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"github.com/JacobPotter/go-zendesk/client"
 )
 
+// GetGroupsIterator returns an Iterator over /groups.json
 func (z *Client) GetGroupsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[Group] {
 	return &Iterator[Group]{
 		CommonOptions: opts.CommonOptions,
@@ -26,6 +29,7 @@ func (z *Client) GetGroupsIterator(ctx context.Context, opts *PaginationOptions)
 	}
 }
 
+// GetGroupsOBP fetches a page of /groups.json with offset based pagination
 func (z *Client) GetGroupsOBP(ctx context.Context, opts *OBPOptions) ([]Group, Page, error) {
 	var data struct {
 		Groups []Group `json:"groups"`
@@ -37,19 +41,19 @@ func (z *Client) GetGroupsOBP(ctx context.Context, opts *OBPOptions) ([]Group, P
 		tmp = &OBPOptions{}
 	}
 
-	u, err := client.AddOptions("/groups.json", tmp)
-
+	u, err := tmp.addOptions("/groups.json")
 	if err != nil {
 		return nil, Page{}, err
 	}
 
-	err = client.GetData(z, ctx, u, &data)
+	err = z.getData(ctx, u, &data)
 	if err != nil {
 		return nil, Page{}, err
 	}
 	return data.Groups, data.Page, nil
 }
 
+// GetGroupsCBP fetches a page of /groups.json with cursor based pagination
 func (z *Client) GetGroupsCBP(ctx context.Context, opts *CBPOptions) ([]Group, client.CursorPaginationMeta, error) {
 	var data struct {
 		Groups []Group                     `json:"groups"`
@@ -61,15 +65,112 @@ func (z *Client) GetGroupsCBP(ctx context.Context, opts *CBPOptions) ([]Group, c
 		tmp = &CBPOptions{}
 	}
 
-	u, err := client.AddOptions("/groups.json", tmp)
-
+	u, err := tmp.addOptions("/groups.json")
 	if err != nil {
 		return nil, data.Meta, err
 	}
 
-	err = client.GetData(z, ctx, u, &data)
+	err = z.getData(ctx, u, &data)
 	if err != nil {
 		return nil, data.Meta, err
 	}
 	return data.Groups, data.Meta, nil
 }
+
+// GroupIteratorOptions are the options accepted by IterateGroups
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/groups/groups/
+type GroupIteratorOptions struct {
+	IteratorOptions
+
+	// ExcludeDeleted omits deleted groups
+	ExcludeDeleted bool `url:"exclude_deleted,omitempty"`
+
+	// Include can take a comma separated list of users.
+	// The side-loads of each page are returned by Iterator.Sideloads.
+	Include string `url:"include,omitempty"`
+}
+
+// Validate checks the options of GroupIteratorOptions
+func (o GroupIteratorOptions) Validate() error {
+	return validateInclude(o.Include, "users")
+}
+
+// IterateGroups returns an Iterator over /groups.json
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/groups/groups/
+func (z *Client) IterateGroups(ctx context.Context, opts *GroupIteratorOptions) *Iterator[Group] {
+	if opts == nil {
+		opts = &GroupIteratorOptions{}
+	}
+	return newSideloadIterator[Group](ctx, z, opts.IteratorOptions, *opts, "/groups.json", "groups")
+}
+
+// GetGroup gets the specified Group
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/groups/groups/
+func (z *Client) GetGroup(ctx context.Context, id int64) (Group, error) {
+	var result struct {
+		Group Group `json:"group"`
+	}
+
+	body, err := z.Get(ctx, fmt.Sprintf("/groups/%d.json", id))
+	if err != nil {
+		return Group{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Group{}, err
+	}
+	return result.Group, nil
+}
+
+// CreateGroup creates a new Group
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/groups/groups/
+func (z *Client) CreateGroup(ctx context.Context, group Group) (Group, error) {
+	var data, result struct {
+		Group Group `json:"group"`
+	}
+	data.Group = group
+
+	body, err := z.Post(ctx, "/groups.json", data)
+	if err != nil {
+		return Group{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Group{}, err
+	}
+	return result.Group, nil
+}
+
+// UpdateGroup updates the specified Group
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/groups/groups/
+func (z *Client) UpdateGroup(ctx context.Context, id int64, group Group) (Group, error) {
+	var data, result struct {
+		Group Group `json:"group"`
+	}
+	data.Group = group
+
+	body, err := z.Put(ctx, fmt.Sprintf("/groups/%d.json", id), data)
+	if err != nil {
+		return Group{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Group{}, err
+	}
+	return result.Group, nil
+}
+
+// DeleteGroup deletes the specified Group
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/groups/groups/
+func (z *Client) DeleteGroup(ctx context.Context, id int64) error {
+	return z.Delete(ctx, fmt.Sprintf("/groups/%d.json", id))
+}
@@ -4,21 +4,20 @@ import (
 	"context"
 	"encoding/json"
 	"github.com/JacobPotter/go-zendesk/client"
-	"time"
 )
 
 type (
 	// GroupMembership is struct for group membership payload
 	// https://developer.zendesk.com/api-reference/ticketing/groups/group_memberships/
 	GroupMembership struct {
-		ID        int64     `json:"id,omitempty"`
-		URL       string    `json:"url,omitempty"`
-		UserID    int64     `json:"user_id"`
-		GroupID   int64     `json:"group_id"`
-		Default   bool      `json:"default"`
-		Name      string    `json:"name"`
-		CreatedAt time.Time `json:"created_at,omitempty"`
-		UpdatedAt time.Time `json:"updated_at,omitempty"`
+		ID        int64      `json:"id,omitempty"`
+		URL       string     `json:"url,omitempty"`
+		UserID    int64      `json:"user_id"`
+		GroupID   int64      `json:"group_id"`
+		Default   bool       `json:"default"`
+		Name      string     `json:"name"`
+		CreatedAt *Timestamp `json:"created_at,omitempty"`
+		UpdatedAt *Timestamp `json:"updated_at,omitempty"`
 	}
 
 	// GroupMembershipListOptions is a struct for options for group membership list
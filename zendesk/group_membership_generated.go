@@ -1,9 +1,9 @@
 // Code generated by Script. DO NOT EDIT.
-// Source: script/codegen/main.go
+// Source: script/codegen/resources.yaml
 //
 // Generated by this command:
 //
-//	go run script/codegen/main.go
+//	go run ./script/codegen
 
 package zendesk
 
@@ -12,6 +12,7 @@ import (
 	"github.com/JacobPotter/go-zendesk/client"
 )
 
+// GetGroupMembershipsIterator returns an Iterator over /group_memberships.json
 func (z *Client) GetGroupMembershipsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[GroupMembership] {
 	return &Iterator[GroupMembership]{
 		CommonOptions: opts.CommonOptions,
@@ -26,6 +27,7 @@ func (z *Client) GetGroupMembershipsIterator(ctx context.Context, opts *Paginati
 	}
 }
 
+// GetGroupMembershipsOBP fetches a page of /group_memberships.json with offset based pagination
 func (z *Client) GetGroupMembershipsOBP(ctx context.Context, opts *OBPOptions) ([]GroupMembership, Page, error) {
 	var data struct {
 		GroupMemberships []GroupMembership `json:"group_memberships"`
@@ -37,19 +39,19 @@ func (z *Client) GetGroupMembershipsOBP(ctx context.Context, opts *OBPOptions) (
 		tmp = &OBPOptions{}
 	}
 
-	u, err := client.AddOptions("/group_memberships.json", tmp)
-
+	u, err := tmp.addOptions("/group_memberships.json")
 	if err != nil {
 		return nil, Page{}, err
 	}
 
-	err = client.GetData(z, ctx, u, &data)
+	err = z.getData(ctx, u, &data)
 	if err != nil {
 		return nil, Page{}, err
 	}
 	return data.GroupMemberships, data.Page, nil
 }
 
+// GetGroupMembershipsCBP fetches a page of /group_memberships.json with cursor based pagination
 func (z *Client) GetGroupMembershipsCBP(ctx context.Context, opts *CBPOptions) ([]GroupMembership, client.CursorPaginationMeta, error) {
 	var data struct {
 		GroupMemberships []GroupMembership           `json:"group_memberships"`
@@ -61,13 +63,12 @@ func (z *Client) GetGroupMembershipsCBP(ctx context.Context, opts *CBPOptions) (
 		tmp = &CBPOptions{}
 	}
 
-	u, err := client.AddOptions("/group_memberships.json", tmp)
-
+	u, err := tmp.addOptions("/group_memberships.json")
 	if err != nil {
 		return nil, data.Meta, err
 	}
 
-	err = client.GetData(z, ctx, u, &data)
+	err = z.getData(ctx, u, &data)
 	if err != nil {
 		return nil, data.Meta, err
 	}
@@ -0,0 +1,342 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/JacobPotter/go-zendesk/client"
+)
+
+// incrementalRequestsPerMinute is the rate limit of the incremental export endpoints
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/incremental_exports/#rate-limits
+const incrementalRequestsPerMinute = 10
+
+// incrementalMaxRetries is the number of times a rate limited request is retried before GetNext fails
+const incrementalMaxRetries = 3
+
+// IncrementalExportOptions are the options accepted by the incremental export iterators.
+//
+// To resume a previous sync, set StartTime to the value of EndTime() (time based exports) or
+// Cursor to the value of Cursor() (cursor based exports) persisted from the previous run.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/incremental_exports/
+type IncrementalExportOptions struct {
+	// StartTime is the Unix epoch time of the first change to export. It is required unless Cursor is set.
+	StartTime int64 `url:"start_time,omitempty"`
+
+	// Cursor resumes a cursor based export. It is not accepted by time based exports.
+	Cursor string `url:"cursor,omitempty"`
+
+	// Include is a comma separated list of side-loads, e.g. "users,groups"
+	Include string `url:"include,omitempty"`
+
+	// PerPage is the number of items per page of cursor based exports, up to 1000
+	PerPage int `url:"per_page,omitempty"`
+
+	// RequestsPerMinute spaces the requests of the iterator. The default is the
+	// endpoint limit of 10 requests per minute.
+	RequestsPerMinute int `url:"-"`
+}
+
+// incrementalListOptions adds the endpoint specific validation to IncrementalExportOptions
+type incrementalListOptions struct {
+	IncrementalExportOptions
+	cursorBased bool
+	sideloads   []string
+}
+
+// Validate checks the start of the export and the side-loads
+func (o incrementalListOptions) Validate() error {
+	var errs []error
+	if o.StartTime == 0 && o.Cursor == "" {
+		errs = append(errs, errors.New("start_time or cursor is required"))
+	}
+	if o.Cursor != "" && !o.cursorBased {
+		errs = append(errs, errors.New("cursor is only accepted by cursor based exports"))
+	}
+	if o.PerPage != 0 && !o.cursorBased {
+		errs = append(errs, errors.New("per_page is only accepted by cursor based exports"))
+	}
+	errs = append(errs, validateInclude(o.Include, o.sideloads...))
+	return errors.Join(errs...)
+}
+
+// incrementalPage is the part of an incremental export response shared by every endpoint
+type incrementalPage struct {
+	AfterCursor string    `json:"after_cursor"`
+	EndTime     int64     `json:"end_time"`
+	NextPage    string    `json:"next_page"`
+	EndOfStream bool      `json:"end_of_stream"`
+	Sideloads   Sideloads `json:"-"`
+}
+
+// IncrementalIterator iterates over an incremental export until the end of the stream.
+// It waits between requests to stay under the export rate limit, and waits for the
+// Retry-After delay when the limit is exceeded anyway, up to 3 times per page.
+type IncrementalIterator[T any] struct {
+	ctx      context.Context
+	z        *Client
+	path     string
+	envelope string
+	opts     incrementalListOptions
+
+	interval    time.Duration
+	lastRequest time.Time
+
+	hasMore   bool
+	cursor    string
+	endTime   int64
+	sideloads Sideloads
+}
+
+func newIncrementalIterator[T any](
+	ctx context.Context, z *Client, path, envelope string, cursorBased bool,
+	opts *IncrementalExportOptions, sideloads ...string,
+) *IncrementalIterator[T] {
+	if opts == nil {
+		opts = &IncrementalExportOptions{}
+	}
+
+	perMinute := opts.RequestsPerMinute
+	if perMinute <= 0 {
+		perMinute = incrementalRequestsPerMinute
+	}
+
+	return &IncrementalIterator[T]{
+		ctx:      ctx,
+		z:        z,
+		path:     path,
+		envelope: envelope,
+		opts: incrementalListOptions{
+			IncrementalExportOptions: *opts,
+			cursorBased:              cursorBased,
+			sideloads:                sideloads,
+		},
+		interval: time.Minute / time.Duration(perMinute),
+		hasMore:  true,
+		cursor:   opts.Cursor,
+		endTime:  opts.StartTime,
+	}
+}
+
+// HasMore returns false once the end of the stream has been reached or a request failed
+func (i *IncrementalIterator[T]) HasMore() bool {
+	return i.hasMore
+}
+
+// Cursor returns the cursor to persist in order to resume a cursor based export
+func (i *IncrementalIterator[T]) Cursor() string {
+	return i.cursor
+}
+
+// EndTime returns the Unix epoch time to persist in order to resume a time based export
+func (i *IncrementalIterator[T]) EndTime() int64 {
+	return i.endTime
+}
+
+// Sideloads returns the collections side-loaded with the last page
+func (i *IncrementalIterator[T]) Sideloads() Sideloads {
+	return i.sideloads
+}
+
+// GetNext fetches the next page of the export.
+// In case of an error, it sets hasMore to false and returns an error.
+func (i *IncrementalIterator[T]) GetNext() ([]T, error) {
+	items, page, err := i.fetch()
+	if err != nil {
+		i.hasMore = false
+		return nil, err
+	}
+
+	i.sideloads = page.Sideloads
+	if i.opts.cursorBased {
+		i.hasMore = !page.EndOfStream && page.AfterCursor != ""
+		if page.AfterCursor != "" {
+			i.cursor = page.AfterCursor
+		}
+		return items, nil
+	}
+
+	// Time based exports continue from end_time. Stop if it does not move forward, which
+	// happens on the last page of endpoints without end_of_stream.
+	previous := i.endTime
+	if page.EndTime != 0 {
+		i.endTime = page.EndTime
+	}
+	i.hasMore = !page.EndOfStream && page.NextPage != "" && i.endTime > previous
+	return items, nil
+}
+
+func (i *IncrementalIterator[T]) fetch() ([]T, incrementalPage, error) {
+	opts := i.opts
+	if i.opts.cursorBased && i.cursor != "" {
+		opts.Cursor = i.cursor
+		opts.StartTime = 0
+	} else {
+		opts.StartTime = i.endTime
+	}
+
+	u, err := addListOptions(i.path, struct{}{}, opts)
+	if err != nil {
+		return nil, incrementalPage{}, err
+	}
+
+	for retries := 0; ; retries++ {
+		if err := i.wait(); err != nil {
+			return nil, incrementalPage{}, err
+		}
+
+		body, err := i.z.Get(i.ctx, u)
+		var apiErr client.Error
+		if errors.As(err, &apiErr) && apiErr.Status() == http.StatusTooManyRequests && retries < incrementalMaxRetries {
+			if err := sleepContext(i.ctx, client.GetRetryWaitTime(apiErr.Resp)); err != nil {
+				return nil, incrementalPage{}, err
+			}
+			continue
+		}
+		if err != nil {
+			return nil, incrementalPage{}, err
+		}
+		return decodeIncrementalPage[T](body, i.envelope)
+	}
+}
+
+// wait blocks until the rate limit allows the next request
+func (i *IncrementalIterator[T]) wait() error {
+	var d time.Duration
+	if !i.lastRequest.IsZero() {
+		d = i.interval - time.Since(i.lastRequest)
+	}
+	if err := sleepContext(i.ctx, d); err != nil {
+		return err
+	}
+	i.lastRequest = time.Now()
+	return nil
+}
+
+// sleepContext waits for d, unless ctx is done first in which case its error is returned
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// decodeIncrementalPage decodes the items under envelope, the pagination fields and the side-loads of a page
+func decodeIncrementalPage[T any](body []byte, envelope string) ([]T, incrementalPage, error) {
+	var (
+		items []T
+		page  incrementalPage
+		raw   map[string]json.RawMessage
+	)
+
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, page, err
+	}
+	if err := json.Unmarshal(body, &page); err != nil {
+		return nil, page, err
+	}
+	if data, ok := raw[envelope]; ok {
+		if err := json.Unmarshal(data, &items); err != nil {
+			return nil, page, fmt.Errorf("%s: %w", envelope, err)
+		}
+	}
+
+	sideloads, err := decodeSideloads(raw, envelope)
+	if err != nil {
+		return nil, page, err
+	}
+	page.Sideloads = sideloads
+	return items, page, nil
+}
+
+// TicketEvent is a ticket audit as returned by the incremental ticket event export
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/incremental_exports/#incremental-ticket-event-export
+type TicketEvent struct {
+	ID          int64                    `json:"id"`
+	TicketID    int64                    `json:"ticket_id"`
+	Timestamp   int64                    `json:"timestamp"`
+	CreatedAt   *Timestamp               `json:"created_at,omitempty"`
+	UpdaterID   int64                    `json:"updater_id"`
+	Via         *Via                     `json:"via,omitempty"`
+	EventType   string                   `json:"event_type"`
+	ChildEvents []map[string]interface{} `json:"child_events,omitempty"`
+}
+
+// IncrementalAPI an interface containing the incremental export methods
+type IncrementalAPI interface {
+	IncrementalTickets(ctx context.Context, opts *IncrementalExportOptions) *IncrementalIterator[Ticket]
+	IncrementalUsers(ctx context.Context, opts *IncrementalExportOptions) *IncrementalIterator[User]
+	IncrementalOrganizations(
+		ctx context.Context, opts *IncrementalExportOptions,
+	) *IncrementalIterator[Organization]
+	IncrementalTicketEvents(ctx context.Context, opts *IncrementalExportOptions) *IncrementalIterator[TicketEvent]
+	IncrementalTicketMetricEvents(
+		ctx context.Context, opts *IncrementalExportOptions,
+	) *IncrementalIterator[TicketMetricEvent]
+}
+
+// IncrementalTickets returns an iterator over the tickets changed since opts.StartTime,
+// using the cursor based export
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/incremental_exports/#incremental-ticket-export-cursor-based
+func (z *Client) IncrementalTickets(ctx context.Context, opts *IncrementalExportOptions) *IncrementalIterator[Ticket] {
+	return newIncrementalIterator[Ticket](ctx, z, "/incremental/tickets/cursor.json", "tickets", true, opts,
+		ticketSideloads...)
+}
+
+// IncrementalUsers returns an iterator over the users changed since opts.StartTime,
+// using the cursor based export
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/incremental_exports/#incremental-user-export-cursor-based
+func (z *Client) IncrementalUsers(ctx context.Context, opts *IncrementalExportOptions) *IncrementalIterator[User] {
+	return newIncrementalIterator[User](ctx, z, "/incremental/users/cursor.json", "users", true, opts,
+		userSideloads...)
+}
+
+// IncrementalOrganizations returns an iterator over the organizations changed since opts.StartTime,
+// using the time based export
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/incremental_exports/#incremental-organization-export
+func (z *Client) IncrementalOrganizations(
+	ctx context.Context, opts *IncrementalExportOptions,
+) *IncrementalIterator[Organization] {
+	return newIncrementalIterator[Organization](ctx, z, "/incremental/organizations.json", "organizations", false,
+		opts)
+}
+
+// IncrementalTicketEvents returns an iterator over the ticket events since opts.StartTime,
+// using the time based export
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/incremental_exports/#incremental-ticket-event-export
+func (z *Client) IncrementalTicketEvents(
+	ctx context.Context, opts *IncrementalExportOptions,
+) *IncrementalIterator[TicketEvent] {
+	return newIncrementalIterator[TicketEvent](ctx, z, "/incremental/ticket_events.json", "ticket_events", false,
+		opts, "comment_events")
+}
+
+// IncrementalTicketMetricEvents returns an iterator over the ticket metric events since opts.StartTime,
+// using the time based export
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/ticket_metric_events/#list-ticket-metric-events
+func (z *Client) IncrementalTicketMetricEvents(
+	ctx context.Context, opts *IncrementalExportOptions,
+) *IncrementalIterator[TicketMetricEvent] {
+	return newIncrementalIterator[TicketMetricEvent](ctx, z, "/incremental/ticket_metric_events.json",
+		"ticket_metric_events", false, opts)
+}
@@ -0,0 +1,235 @@
+package zendesk
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/JacobPotter/go-zendesk/client"
+	"github.com/JacobPotter/go-zendesk/testhelper"
+)
+
+func TestIncrementalTickets(t *testing.T) {
+	var queries []url.Values
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.Query())
+		fixture := "tickets_cursor_1.json"
+		if r.URL.Query().Has("cursor") {
+			fixture = "tickets_cursor_2.json"
+		}
+		_, err := w.Write(testhelper.ReadFixture(t, filepath.Join(http.MethodGet, "incremental", fixture)))
+		if err != nil {
+			t.Fatalf("Failed to write fixture: %s", err)
+		}
+	}))
+	c := NewTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	it := c.IncrementalTickets(ctx, &IncrementalExportOptions{
+		StartTime:         1672567200,
+		Include:           "users,groups",
+		RequestsPerMinute: 60000,
+	})
+
+	tickets, err := it.GetNext()
+	if err != nil {
+		t.Fatalf("Failed to export tickets: %s", err)
+	}
+	if len(tickets) != 1 || !it.HasMore() {
+		t.Fatalf("Unexpected first page %v, has more %t", tickets, it.HasMore())
+	}
+	sideloads := it.Sideloads()
+	if len(sideloads.Users) != 1 || sideloads.Users[0].ID != 10 || len(sideloads.Groups) != 1 {
+		t.Fatalf("Unexpected side-loads %v", sideloads)
+	}
+
+	tickets, err = it.GetNext()
+	if err != nil {
+		t.Fatalf("Failed to export tickets: %s", err)
+	}
+	if len(tickets) != 1 || tickets[0].ID != 2 || it.HasMore() {
+		t.Fatalf("Unexpected last page %v, has more %t", tickets, it.HasMore())
+	}
+	if it.Cursor() != "MTU3NjYxMzUzOS4wfHw0Njh8" {
+		t.Fatalf("Unexpected cursor %s", it.Cursor())
+	}
+
+	if queries[0].Get("start_time") != "1672567200" || queries[0].Get("include") != "users,groups" {
+		t.Fatalf("Unexpected first query %s", queries[0].Encode())
+	}
+	if queries[1].Has("start_time") || queries[1].Get("cursor") != "MTU3NjYxMzUzOS4wfHw0Njd8" {
+		t.Fatalf("Unexpected second query %s", queries[1].Encode())
+	}
+}
+
+func TestIncrementalUsersAreNotSideloads(t *testing.T) {
+	mockAPI := testhelper.NewMockAPI(t, http.MethodGet, filepath.Join("incremental", "users_cursor.json"))
+	c := NewTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	it := c.IncrementalUsers(ctx, &IncrementalExportOptions{Cursor: "MTU3NjYxMzUzOS4wfHw0Njh8"})
+	users, err := it.GetNext()
+	if err != nil {
+		t.Fatalf("Failed to export users: %s", err)
+	}
+	if len(users) != 2 || len(it.Sideloads().Users) != 0 {
+		t.Fatalf("Unexpected users %v and side-loads %v", users, it.Sideloads())
+	}
+}
+
+func TestIncrementalUsersRejectIdentities(t *testing.T) {
+	mockAPI := testhelper.NewMockAPI(t, http.MethodGet, filepath.Join("incremental", "users_cursor.json"))
+	c := NewTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	// identities have no model, so they could not be returned by Sideloads
+	it := c.IncrementalUsers(ctx, &IncrementalExportOptions{StartTime: 1, Include: "identities"})
+	var optErr *InvalidOptionError
+	if _, err := it.GetNext(); !errors.As(err, &optErr) || optErr.Option != "include" {
+		t.Fatalf("Expected an invalid include error, got %v", err)
+	}
+}
+
+func TestIncrementalOrganizations(t *testing.T) {
+	mockAPI := testhelper.NewMockAPI(t, http.MethodGet, filepath.Join("incremental", "organizations.json"))
+	c := NewTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	it := c.IncrementalOrganizations(ctx, &IncrementalExportOptions{StartTime: 1672567200})
+	orgs, err := it.GetNext()
+	if err != nil {
+		t.Fatalf("Failed to export organizations: %s", err)
+	}
+	if len(orgs) != 1 || it.HasMore() || it.EndTime() != 1672740000 {
+		t.Fatalf("Unexpected organizations %v, has more %t, end time %d", orgs, it.HasMore(), it.EndTime())
+	}
+}
+
+func TestIncrementalTicketMetricEventsStopWhenEndTimeStalls(t *testing.T) {
+	requests := 0
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		_, err := w.Write(testhelper.ReadFixture(t,
+			filepath.Join(http.MethodGet, "incremental", "ticket_metric_events.json")))
+		if err != nil {
+			t.Fatalf("Failed to write fixture: %s", err)
+		}
+	}))
+	c := NewTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	it := c.IncrementalTicketMetricEvents(ctx, &IncrementalExportOptions{
+		StartTime:         1603716000,
+		RequestsPerMinute: 60000,
+	})
+	for it.HasMore() {
+		events, err := it.GetNext()
+		if err != nil {
+			t.Fatalf("Failed to export ticket metric events: %s", err)
+		}
+		if len(events) != 2 || events[1].Metric != "reply_time" {
+			t.Fatalf("Unexpected events %v", events)
+		}
+	}
+	if requests != 2 {
+		t.Fatalf("Expected the export to stop once end_time stops moving, got %d requests", requests)
+	}
+}
+
+func TestIncrementalRetriesRateLimitedRequests(t *testing.T) {
+	requests := 0
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_, err := w.Write(testhelper.ReadFixture(t, filepath.Join(http.MethodGet, "incremental", "organizations.json")))
+		if err != nil {
+			t.Fatalf("Failed to write fixture: %s", err)
+		}
+	}))
+	c := NewTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	it := c.IncrementalOrganizations(ctx, &IncrementalExportOptions{
+		StartTime:         1672567200,
+		RequestsPerMinute: 60000,
+	})
+	if _, err := it.GetNext(); err != nil {
+		t.Fatalf("Failed to export organizations: %s", err)
+	}
+	if requests != 2 {
+		t.Fatalf("Expected the rate limited request to be retried, got %d requests", requests)
+	}
+}
+
+func TestIncrementalGivesUpOnRateLimit(t *testing.T) {
+	requests := 0
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	c := NewTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	it := c.IncrementalOrganizations(ctx, &IncrementalExportOptions{
+		StartTime:         1672567200,
+		RequestsPerMinute: 60000,
+	})
+	_, err := it.GetNext()
+	var apiErr client.Error
+	if !errors.As(err, &apiErr) || apiErr.Status() != http.StatusTooManyRequests {
+		t.Fatalf("Expected the rate limit error, got %v", err)
+	}
+	if requests != incrementalMaxRetries+1 {
+		t.Fatalf("Expected %d requests, got %d", incrementalMaxRetries+1, requests)
+	}
+}
+
+func TestIncrementalRateLimitWaitIsCancelled(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	c := NewTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	timeout, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+
+	it := c.IncrementalOrganizations(timeout, &IncrementalExportOptions{StartTime: 1672567200})
+	if _, err := it.GetNext(); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected the wait to be cancelled, got %v", err)
+	}
+}
+
+func TestIncrementalInvalidOptions(t *testing.T) {
+	mockAPI := testhelper.NewMockAPI(t, http.MethodGet, filepath.Join("incremental", "organizations.json"))
+	c := NewTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	tests := map[string]*IncrementalExportOptions{
+		"missing start":   {},
+		"cursor":          {Cursor: "abc"},
+		"unknown include": {StartTime: 1, Include: "brands"},
+	}
+	for name, opts := range tests {
+		t.Run(name, func(t *testing.T) {
+			it := c.IncrementalOrganizations(context.Background(), opts)
+			if _, err := it.GetNext(); err == nil {
+				t.Fatal("Expected an error")
+			}
+			if it.HasMore() {
+				t.Fatal("Expected iterator to stop after a validation error")
+			}
+		})
+	}
+}
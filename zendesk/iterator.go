@@ -2,7 +2,13 @@ package zendesk
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"github.com/JacobPotter/go-zendesk/client"
+	"github.com/google/go-querystring/query"
+	"net/url"
+	"slices"
+	"strings"
 )
 
 // PaginationOptions struct represents general pagination options.
@@ -22,6 +28,11 @@ func NewPaginationOptions() *PaginationOptions {
 	}
 }
 
+// CommonOptions holds the query parameters of every endpoint in one struct.
+//
+// Deprecated: fields meant for one endpoint are sent to all of them (e.g. active=false
+// on ticket listings). Use the endpoint specific iterator options such as
+// TicketIteratorOptions with the matching Iterate method instead.
 type CommonOptions struct {
 	Active        bool     `url:"active"`
 	Role          string   `url:"role,omitempty"`
@@ -58,6 +69,9 @@ type CommonOptions struct {
 type CBPOptions struct {
 	client.CursorPagination
 	CommonOptions
+
+	// ListOptions holds endpoint specific query parameters. When set, they are sent in place of CommonOptions.
+	ListOptions ListOptions `url:"-"`
 }
 
 // OBPOptions struct is used to specify options for listing objects in OBP (Offset Based Pagination).
@@ -66,6 +80,146 @@ type CBPOptions struct {
 type OBPOptions struct {
 	PageOptions
 	CommonOptions
+
+	// ListOptions holds endpoint specific query parameters. When set, they are sent in place of CommonOptions.
+	ListOptions ListOptions `url:"-"`
+}
+
+// ListOptions is implemented by the endpoint specific iterator options.
+// Validate reports option values the endpoint does not accept.
+type ListOptions interface {
+	Validate() error
+}
+
+// IteratorOptions holds the pagination settings embedded in every endpoint specific iterator options struct.
+// The zero value uses cursor based pagination with 100 items per page.
+type IteratorOptions struct {
+	PageSize int  `url:"-"` //default is 100
+	UseOBP   bool `url:"-"` //default is false
+}
+
+// InvalidOptionError is returned when a list option is set to a value the endpoint does not accept.
+type InvalidOptionError struct {
+	Option  string
+	Value   string
+	Allowed []string
+}
+
+func (e *InvalidOptionError) Error() string {
+	if len(e.Allowed) == 0 {
+		return fmt.Sprintf("invalid %s %q", e.Option, e.Value)
+	}
+	return fmt.Sprintf("invalid %s %q: must be one of %s", e.Option, e.Value, strings.Join(e.Allowed, ", "))
+}
+
+// validateOption checks that value is empty or one of allowed.
+func validateOption(option string, value string, allowed ...string) error {
+	if value == "" || slices.Contains(allowed, value) {
+		return nil
+	}
+	return &InvalidOptionError{Option: option, Value: value, Allowed: allowed}
+}
+
+// validateSort checks a cursor pagination sort value, which is one of fields optionally prefixed by "-" for
+// descending order.
+func validateSort(value string, fields ...string) error {
+	if value == "" || slices.Contains(fields, strings.TrimPrefix(value, "-")) {
+		return nil
+	}
+	return &InvalidOptionError{Option: "sort", Value: value, Allowed: fields}
+}
+
+// validateSortMode reports the sort options which do not apply to the pagination mode of o: CBP sorts
+// with sort, while OBP sorts with sort_by and sort_order.
+func (o IteratorOptions) validateSortMode(sort, sortBy, sortOrder string) error {
+	if o.UseOBP {
+		if sort != "" {
+			return errors.New("sort is only used by CBP, use sort_by and sort_order with UseOBP")
+		}
+		return nil
+	}
+	if sortBy != "" || sortOrder != "" {
+		return errors.New("sort_by and sort_order are only used by OBP, use sort or set UseOBP")
+	}
+	return nil
+}
+
+// requireOBP rejects cursor based pagination for the endpoints which only support OBP
+func (o IteratorOptions) requireOBP() error {
+	if !o.UseOBP {
+		return cbpUnsupported()
+	}
+	return nil
+}
+
+// cbpUnsupported is the error of a cursor based page request to an endpoint which only supports OBP
+func cbpUnsupported() error {
+	return &InvalidOptionError{Option: "pagination", Value: "cbp", Allowed: []string{"obp"}}
+}
+
+// validateInclude checks that every side-load of a comma separated include value is one of allowed.
+func validateInclude(value string, allowed ...string) error {
+	if value == "" {
+		return nil
+	}
+	for _, v := range strings.Split(value, ",") {
+		if !slices.Contains(allowed, strings.TrimSpace(v)) {
+			return &InvalidOptionError{Option: "include", Value: v, Allowed: allowed}
+		}
+	}
+	return nil
+}
+
+// requireID reports a missing path parameter of a nested endpoint.
+func requireID(option string, id int64) error {
+	if id == 0 {
+		return fmt.Errorf("%s is required", option)
+	}
+	return nil
+}
+
+// addOptions builds the query string of an OBP page request
+func (o *OBPOptions) addOptions(path string) (string, error) {
+	if o.ListOptions == nil {
+		return client.AddOptions(path, o)
+	}
+	return addListOptions(path, o.PageOptions, o.ListOptions)
+}
+
+// addOptions builds the query string of a CBP page request
+func (o *CBPOptions) addOptions(path string) (string, error) {
+	if o.ListOptions == nil {
+		return client.AddOptions(path, o)
+	}
+	return addListOptions(path, o.CursorPagination, o.ListOptions)
+}
+
+// addListOptions validates opts and encodes them together with the pagination parameters
+func addListOptions(path string, pagination interface{}, opts ListOptions) (string, error) {
+	if err := opts.Validate(); err != nil {
+		return path, err
+	}
+
+	u, err := url.Parse(path)
+	if err != nil {
+		return path, err
+	}
+
+	qs, err := query.Values(opts)
+	if err != nil {
+		return path, err
+	}
+
+	pqs, err := query.Values(pagination)
+	if err != nil {
+		return path, err
+	}
+	for key, values := range pqs {
+		qs[key] = values
+	}
+
+	u.RawQuery = qs.Encode()
+	return u.String(), nil
 }
 
 // ObpFunc defines the signature of the function used to list objects in OBP.
@@ -78,6 +232,8 @@ type CbpFunc[T any] func(ctx context.Context, opts *CBPOptions) ([]T, client.Cur
 // It holds state for iteration, including the current page size, a flag indicating more pages, pagination type (OBP or CBP), and sorting options.
 type Iterator[T any] struct {
 	CommonOptions
+	listOptions ListOptions
+	sideloads   Sideloads
 	// generic fields
 	pageSize int
 	hasMore  bool
@@ -95,11 +251,41 @@ type Iterator[T any] struct {
 	cbpFunc CbpFunc[T]
 }
 
+// newListIterator returns an Iterator which sends opts with every page request.
+// id fills the path parameter of nested endpoints such as /organizations/%d/tickets.json.
+func newListIterator[T any](
+	ctx context.Context, pagination IteratorOptions, opts ListOptions, id int64, obpFunc ObpFunc[T], cbpFunc CbpFunc[T],
+) *Iterator[T] {
+	pageSize := pagination.PageSize
+	if pageSize == 0 {
+		pageSize = 100
+	}
+
+	return &Iterator[T]{
+		CommonOptions: CommonOptions{Id: id},
+		listOptions:   opts,
+		pageSize:      pageSize,
+		hasMore:       true,
+		isCBP:         !pagination.UseOBP,
+		pageAfter:     "",
+		pageIndex:     1,
+		ctx:           ctx,
+		obpFunc:       obpFunc,
+		cbpFunc:       cbpFunc,
+	}
+}
+
 // HasMore() returns a boolean indicating whether more pages are available for iteration.
 func (i *Iterator[T]) HasMore() bool {
 	return i.hasMore
 }
 
+// Sideloads returns the collections side-loaded with the last page. It is only filled by the iterators
+// of endpoints accepting an Include option, such as IterateTickets.
+func (i *Iterator[T]) Sideloads() Sideloads {
+	return i.sideloads
+}
+
 // GetNext() retrieves the next batch of objects according to the current pagination and sorting options.
 // It updates the state of the iterator for subsequent calls.
 // In case of an error, it sets hasMore to false and returns an error.
@@ -111,6 +297,7 @@ func (i *Iterator[T]) GetNext() ([]T, error) {
 				Page:    i.pageIndex,
 			},
 			CommonOptions: i.CommonOptions,
+			ListOptions:   i.listOptions,
 		}
 		results, page, err := i.obpFunc(i.ctx, obpOps)
 		if err != nil {
@@ -128,6 +315,7 @@ func (i *Iterator[T]) GetNext() ([]T, error) {
 			PageAfter: i.pageAfter,
 		},
 		CommonOptions: i.CommonOptions,
+		ListOptions:   i.listOptions,
 	}
 	results, meta, err := i.cbpFunc(i.ctx, cbpOps)
 	if err != nil {
@@ -138,3 +326,16 @@ func (i *Iterator[T]) GetNext() ([]T, error) {
 	i.pageAfter = meta.AfterCursor
 	return results, nil
 }
+
+// collect returns all the items of it
+func collect[T any](it *Iterator[T]) ([]T, error) {
+	var all []T
+	for it.HasMore() {
+		items, err := it.GetNext()
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, items...)
+	}
+	return all, nil
+}
@@ -57,3 +57,49 @@ func TestGetNext(t *testing.T) {
 	assert.Equal(t, []int{1, 2, 3}, results)
 	assert.Equal(t, true, iter.HasMore())
 }
+
+// Test that endpoint specific options replace CommonOptions in the query string
+func TestAddListOptions(t *testing.T) {
+	opts := &CBPOptions{
+		CursorPagination: client.CursorPagination{PageSize: 10, PageAfter: "abc"},
+		ListOptions:      TicketIteratorOptions{Sort: "-updated_at"},
+	}
+
+	u, err := opts.addOptions("/tickets.json")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "/tickets.json?page%5Bafter%5D=abc&page%5Bsize%5D=10&sort=-updated_at", u)
+}
+
+func TestAddListOptionsInvalidSort(t *testing.T) {
+	opts := &OBPOptions{
+		ListOptions: TicketIteratorOptions{IteratorOptions: IteratorOptions{UseOBP: true}, SortBy: "priority"},
+	}
+
+	_, err := opts.addOptions("/tickets.json")
+
+	var optErr *InvalidOptionError
+	assert.ErrorAs(t, err, &optErr)
+	assert.Equal(t, "sort_by", optErr.Option)
+}
+
+func TestValidateSortMode(t *testing.T) {
+	assert.NoError(t, IteratorOptions{}.validateSortMode("-id", "", ""))
+	assert.NoError(t, IteratorOptions{UseOBP: true}.validateSortMode("", "id", "asc"))
+	assert.Error(t, IteratorOptions{}.validateSortMode("", "id", ""))
+	assert.Error(t, IteratorOptions{}.validateSortMode("", "", "desc"))
+	assert.Error(t, IteratorOptions{UseOBP: true}.validateSortMode("-id", "", ""))
+
+	// the business rule listings only sort with OBP
+	assert.Error(t, MacroIteratorOptions{SortBy: "position"}.Validate())
+	assert.Error(t, TriggerIteratorOptions{SortOrder: "desc"}.Validate())
+	assert.NoError(t, TriggerIteratorOptions{IteratorOptions: IteratorOptions{UseOBP: true}, SortBy: "position"}.Validate())
+}
+
+func TestNewListIteratorDefaults(t *testing.T) {
+	iter := newListIterator[int](ctx, IteratorOptions{}, TicketIteratorOptions{}, 0, mockObpFunc, mockCbpFunc)
+
+	assert.Equal(t, 100, iter.pageSize)
+	assert.Equal(t, true, iter.isCBP)
+	assert.Equal(t, true, iter.HasMore())
+}
@@ -0,0 +1,245 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/JacobPotter/go-zendesk/client"
+)
+
+// Job statuses reported by JobStatus.Status
+const (
+	JobStatusQueued    = "queued"
+	JobStatusWorking   = "working"
+	JobStatusFailed    = "failed"
+	JobStatusCompleted = "completed"
+	JobStatusKilled    = "killed"
+)
+
+// JobStatus is the status of a background job queued by a bulk endpoint
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/job_statuses/
+type JobStatus struct {
+	ID       string      `json:"id"`
+	URL      string      `json:"url,omitempty"`
+	JobType  string      `json:"job_type,omitempty"`
+	Status   string      `json:"status"`
+	Total    int         `json:"total"`
+	Progress int         `json:"progress"`
+	Message  string      `json:"message,omitempty"`
+	Results  []JobResult `json:"results,omitempty"`
+}
+
+// Done reports whether the job reached one of the final statuses completed, failed or killed
+func (s JobStatus) Done() bool {
+	return s.Status == JobStatusCompleted || s.Status == JobStatusFailed || s.Status == JobStatusKilled
+}
+
+// jobStatus implements Job
+func (s *JobStatus) jobStatus() *JobStatus {
+	return s
+}
+
+// Job is a job which can be waited on: *JobStatus and *BulkJob
+type Job interface {
+	jobStatus() *JobStatus
+}
+
+// JobsOf returns jobs as a []Job, e.g. to wait on the []BulkJob returned by a bulk operation
+func JobsOf[T any, P interface {
+	*T
+	Job
+}](jobs []T) []Job {
+	result := make([]Job, len(jobs))
+	for i := range jobs {
+		result[i] = P(&jobs[i])
+	}
+	return result
+}
+
+// JobError is returned when a job waited on failed or was killed
+type JobError struct {
+	JobStatus JobStatus
+}
+
+func (e *JobError) Error() string {
+	return fmt.Sprintf("job %s %s: %s", e.JobStatus.ID, e.JobStatus.Status, e.JobStatus.Message)
+}
+
+// JobWaitOptions configures the polling of WaitForJob and WaitForJobs
+type JobWaitOptions struct {
+	// Interval is the delay before the first poll, doubled after every poll. The default is 1 second.
+	Interval time.Duration
+
+	// MaxInterval caps the delay between polls. The default is 30 seconds.
+	MaxInterval time.Duration
+}
+
+// JobStatusAPI an interface containing the job status methods
+type JobStatusAPI interface {
+	GetJobStatus(ctx context.Context, jobID string) (JobStatus, error)
+	GetManyJobStatuses(ctx context.Context, jobIDs []string) ([]JobStatus, error)
+	WaitForJob(ctx context.Context, job Job, opts *JobWaitOptions) error
+	WaitForJobs(ctx context.Context, jobs []Job, opts *JobWaitOptions) error
+}
+
+// GetJobStatus gets the status of a job
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/job_statuses/#show-job-status
+func (z *Client) GetJobStatus(ctx context.Context, jobID string) (JobStatus, error) {
+	body, err := z.Get(ctx, fmt.Sprintf("/job_statuses/%s.json", jobID))
+	if err != nil {
+		return JobStatus{}, err
+	}
+	return decodeJobStatus(body)
+}
+
+// GetManyJobStatuses gets the statuses of up to 100 jobs
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/job_statuses/#show-many-job-statuses
+func (z *Client) GetManyJobStatuses(ctx context.Context, jobIDs []string) ([]JobStatus, error) {
+	var result struct {
+		JobStatuses []JobStatus `json:"job_statuses"`
+	}
+
+	if len(jobIDs) > bulkLimit {
+		return nil, fmt.Errorf("at most %d job ids can be requested at once, got %d", bulkLimit, len(jobIDs))
+	}
+
+	u, err := client.AddOptions("/job_statuses/show_many.json", struct {
+		IDs []string `url:"ids,comma"`
+	}{jobIDs})
+	if err != nil {
+		return nil, err
+	}
+
+	err = client.GetData(z, ctx, u, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.JobStatuses, nil
+}
+
+// WaitForJob polls job with exponential backoff until it is completed, failed or killed, and updates it
+// with the final status including its results. A *JobError is returned if the job failed or was killed.
+func (z *Client) WaitForJob(ctx context.Context, job Job, opts *JobWaitOptions) error {
+	return z.WaitForJobs(ctx, []Job{job}, opts)
+}
+
+// WaitForJobs waits for jobs like WaitForJob. The pending jobs are polled together, 100 per request,
+// so that waiting on many jobs does not exhaust the rate limit; a rate limited request slows polling
+// down to its Retry-After delay. An error is returned for a job Zendesk does not know, e.g. once expired.
+func (z *Client) WaitForJobs(ctx context.Context, jobs []Job, opts *JobWaitOptions) error {
+	interval, maxInterval := time.Second, 30*time.Second
+	if opts != nil && opts.Interval > 0 {
+		interval = opts.Interval
+	}
+	if opts != nil && opts.MaxInterval > 0 {
+		maxInterval = opts.MaxInterval
+	}
+
+	for {
+		pending := map[string][]*JobStatus{}
+		var ids []string
+		for _, j := range jobs {
+			s := j.jobStatus()
+			if s.Done() {
+				continue
+			}
+			if _, ok := pending[s.ID]; !ok {
+				ids = append(ids, s.ID)
+			}
+			pending[s.ID] = append(pending[s.ID], s)
+		}
+		if len(ids) == 0 {
+			break
+		}
+
+		if err := sleepContext(ctx, interval); err != nil {
+			return err
+		}
+		interval = min(interval*2, maxInterval)
+
+		for offset := 0; offset < len(ids); offset += bulkLimit {
+			chunk := ids[offset:min(offset+bulkLimit, len(ids))]
+			statuses, err := z.GetManyJobStatuses(ctx, chunk)
+			var apiErr client.Error
+			if errors.As(err, &apiErr) && apiErr.Status() == http.StatusTooManyRequests {
+				// the jobs of the chunk stay pending and are polled again once the rate limit allows it
+				interval = max(interval, client.GetRetryWaitTime(apiErr.Resp))
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			for _, status := range statuses {
+				for _, s := range pending[status.ID] {
+					*s = status
+				}
+				delete(pending, status.ID)
+			}
+			for _, id := range chunk {
+				if _, ok := pending[id]; ok {
+					return fmt.Errorf("job %s was not found, its status may have expired", id)
+				}
+			}
+		}
+	}
+
+	var errs []error
+	for _, j := range jobs {
+		if s := j.jobStatus(); s.Status != JobStatusCompleted {
+			errs = append(errs, &JobError{JobStatus: *s})
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// JobResult is the result of one item of a job. Jobs creating items report its Index in the request,
+// jobs updating or deleting items report its ID.
+type JobResult struct {
+	ID      int64  `json:"id,omitempty"`
+	Index   *int   `json:"index,omitempty"`
+	Action  string `json:"action,omitempty"`
+	Success bool   `json:"success,omitempty"`
+	Status  string `json:"status,omitempty"`
+	Error   string `json:"error,omitempty"`
+	Errors  string `json:"errors,omitempty"`
+	Details string `json:"details,omitempty"`
+}
+
+// BulkJob is the job queued for one chunk of a bulk operation
+type BulkJob struct {
+	JobStatus
+
+	// Offset is the index in the input of the first item of the chunk
+	Offset int
+
+	// IDs are the ids of the chunk in input order, for operations taking ids or existing items
+	IDs []int64
+}
+
+// InputIndex returns the index in the input of the bulk operation of the item a result refers to
+func (j BulkJob) InputIndex(r JobResult) (int, bool) {
+	if r.Index != nil {
+		return j.Offset + *r.Index, true
+	}
+	if i := slices.Index(j.IDs, r.ID); i >= 0 && r.ID != 0 {
+		return j.Offset + i, true
+	}
+	return 0, false
+}
+
+// decodeJobStatus decodes a {"job_status": {...}} response
+func decodeJobStatus(body []byte) (JobStatus, error) {
+	var result struct {
+		JobStatus JobStatus `json:"job_status"`
+	}
+	err := json.Unmarshal(body, &result)
+	return result.JobStatus, err
+}
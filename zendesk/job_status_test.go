@@ -0,0 +1,138 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/JacobPotter/go-zendesk/testhelper"
+)
+
+func TestGetJobStatus(t *testing.T) {
+	mockAPI := testhelper.NewMockAPI(t, http.MethodGet, "job_status.json")
+	c := NewTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	status, err := c.GetJobStatus(ctx, "8b726e606741012ffc2d782bcb7848fe")
+	if err != nil {
+		t.Fatalf("Failed to get job status: %s", err)
+	}
+	if !status.Done() || len(status.Results) != 2 {
+		t.Fatalf("Unexpected job status %v", status)
+	}
+	failed := status.Results[1]
+	if failed.Success || failed.Errors != "Status: closed prevents ticket update" {
+		t.Fatalf("Unexpected result %v", failed)
+	}
+}
+
+func TestWaitForJobs(t *testing.T) {
+	polls := 0
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		polls++
+		ids := strings.Split(r.URL.Query().Get("ids"), ",")
+
+		var data struct {
+			JobStatuses []JobStatus `json:"job_statuses"`
+		}
+		for _, id := range ids {
+			status := JobStatus{ID: id, Status: JobStatusWorking}
+			if polls > 1 {
+				status.Status = JobStatusCompleted
+				status.Results = []JobResult{{ID: 101, Action: "update", Success: true, Status: "Updated"}}
+			}
+			if id == "b" && polls > 1 {
+				status.Status = JobStatusFailed
+				status.Message = "Job failed"
+			}
+			data.JobStatuses = append(data.JobStatuses, status)
+		}
+		if err := json.NewEncoder(w).Encode(data); err != nil {
+			t.Fatalf("Failed to write job statuses: %s", err)
+		}
+	}))
+	c := NewTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	jobs := []BulkJob{
+		{JobStatus: JobStatus{ID: "a", Status: JobStatusQueued}, Offset: 0, IDs: []int64{100, 101}},
+		{JobStatus: JobStatus{ID: "b", Status: JobStatusQueued}, Offset: 100, IDs: []int64{200}},
+	}
+	err := c.WaitForJobs(ctx, JobsOf(jobs), &JobWaitOptions{Interval: time.Millisecond})
+
+	var jobErr *JobError
+	if !errors.As(err, &jobErr) || jobErr.JobStatus.ID != "b" {
+		t.Fatalf("Expected job b to fail, got %v", err)
+	}
+	if polls != 2 {
+		t.Fatalf("Expected both jobs to be polled together twice, got %d polls", polls)
+	}
+	if jobs[0].Status != JobStatusCompleted || len(jobs[0].Results) != 1 {
+		t.Fatalf("Unexpected job %v", jobs[0])
+	}
+	if index, ok := jobs[0].InputIndex(jobs[0].Results[0]); !ok || index != 1 {
+		t.Fatalf("Expected result to map to input index 1, got %d", index)
+	}
+}
+
+func TestWaitForJobsMissingJob(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, err := w.Write([]byte(`{"job_statuses": [{"id": "a", "status": "working"}]}`))
+		if err != nil {
+			t.Fatalf("Failed to write job statuses: %s", err)
+		}
+	}))
+	c := NewTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	jobs := []Job{&JobStatus{ID: "a", Status: JobStatusQueued}, &JobStatus{ID: "expired", Status: JobStatusQueued}}
+	err := c.WaitForJobs(ctx, jobs, &JobWaitOptions{Interval: time.Millisecond})
+	if err == nil || !strings.Contains(err.Error(), "expired") {
+		t.Fatalf("Expected an error for the missing job, got %v", err)
+	}
+}
+
+func TestWaitForJobsRateLimited(t *testing.T) {
+	polls := 0
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		polls++
+		if polls == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_, err := w.Write([]byte(`{"job_statuses": [{"id": "a", "status": "completed"}]}`))
+		if err != nil {
+			t.Fatalf("Failed to write job statuses: %s", err)
+		}
+	}))
+	c := NewTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	job := JobStatus{ID: "a", Status: JobStatusQueued}
+	if err := c.WaitForJob(ctx, &job, &JobWaitOptions{Interval: time.Millisecond}); err != nil {
+		t.Fatalf("Failed to wait for job: %s", err)
+	}
+	if polls != 2 || job.Status != JobStatusCompleted {
+		t.Fatalf("Expected the rate limited poll to be retried, got %d polls and job %v", polls, job)
+	}
+}
+
+func TestWaitForJobContextCancelled(t *testing.T) {
+	mockAPI := testhelper.NewMockAPI(t, http.MethodGet, "job_status.json")
+	c := NewTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+
+	job := JobStatus{ID: "8b726e606741012ffc2d782bcb7848fe", Status: JobStatusQueued}
+	if err := c.WaitForJob(cancelled, &job, nil); !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected context.Canceled, got %v", err)
+	}
+}
@@ -3,18 +3,17 @@ package zendesk
 import (
 	"context"
 	"encoding/json"
-	"time"
 )
 
 // Locale is zendesk locale JSON payload format
 // https://developer.zendesk.com/rest_api/docs/support/locales
 type Locale struct {
-	ID        int64     `json:"id"`
-	URL       string    `json:"url"`
-	Locale    string    `json:"locale"`
-	Name      string    `json:"name"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID        int64      `json:"id"`
+	URL       string     `json:"url"`
+	Locale    string     `json:"locale"`
+	Name      string     `json:"name"`
+	CreatedAt *Timestamp `json:"created_at,omitempty"`
+	UpdatedAt *Timestamp `json:"updated_at,omitempty"`
 }
 
 // LocaleAPI an interface containing all of the local related zendesk methods
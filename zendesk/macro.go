@@ -2,34 +2,36 @@ package zendesk
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/JacobPotter/go-zendesk/client"
-	"time"
 )
 
 // Macro is information about zendesk macro
 type Macro struct {
 	Actions     []Action    `json:"actions"`
 	Active      bool        `json:"active"`
-	CreatedAt   time.Time   `json:"created_at,omitempty"`
+	CreatedAt   *Timestamp  `json:"created_at,omitempty"`
 	Description string      `json:"description,omitempty"`
 	ID          int64       `json:"id,omitempty"`
 	Position    int         `json:"position,omitempty"`
 	Restriction interface{} `json:"restriction,omitempty"`
 	Title       string      `json:"title"`
-	UpdatedAt   time.Time   `json:"updated_at,omitempty"`
+	UpdatedAt   *Timestamp  `json:"updated_at,omitempty"`
 	URL         string      `json:"url,omitempty"`
+
+	// Extra keeps the macro fields this struct lacks, see SetKeepExtraFields
+	Extra ExtraFields `json:"-"`
 }
 
 // MacroListOptions is parameters used of GetMacros
 type MacroListOptions struct {
-	Access       string `json:"access"`
-	Active       string `json:"active"`
-	Category     int    `json:"category"`
-	GroupID      int    `json:"group_id"`
-	Include      string `json:"include"`
-	OnlyViewable bool   `json:"only_viewable"`
+	Access       string `url:"access,omitempty"`
+	Active       string `url:"active,omitempty"`
+	Category     int    `url:"category,omitempty"`
+	GroupID      int    `url:"group_id,omitempty"`
+	Include      string `url:"include,omitempty"`
+	OnlyViewable bool   `url:"only_viewable,omitempty"`
 
 	PageOptions
 
@@ -41,6 +43,48 @@ type MacroListOptions struct {
 	SortOrder string `url:"sort_order,omitempty"`
 }
 
+// MacroIteratorOptions are the options accepted by IterateMacros
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/macros/#list-macros
+type MacroIteratorOptions struct {
+	IteratorOptions
+
+	// Access can take "personal", "agents", "shared" or "account"
+	Access string `url:"access,omitempty"`
+
+	// Active filters by active or inactive macros when set
+	Active       *bool `url:"active,omitempty"`
+	Category     int64 `url:"category,omitempty"`
+	GroupID      int64 `url:"group_id,omitempty"`
+	OnlyViewable bool  `url:"only_viewable,omitempty"`
+
+	// Include can take "usage_1h", "usage_24h", "usage_7d" or "usage_30d"
+	Include string `url:"include,omitempty"`
+
+	// SortBy is used by OBP and can take "alphabetical", "created_at", "updated_at", "usage_1h",
+	// "usage_24h", "usage_7d", "usage_30d" or "position"
+	SortBy string `url:"sort_by,omitempty"`
+
+	// SortOrder is used by OBP and can take "asc" or "desc"
+	SortOrder string `url:"sort_order,omitempty"`
+}
+
+// Validate checks the access, include and sort options of MacroIteratorOptions
+func (o MacroIteratorOptions) Validate() error {
+	return errors.Join(
+		validateOption("access", o.Access, "personal", "agents", "shared", "account"),
+		validateOption("include", o.Include, "usage_1h", "usage_24h", "usage_7d", "usage_30d"),
+		validateOption("sort_by", o.SortBy, businessRuleSortByValues...),
+		validateOption("sort_order", o.SortOrder, "asc", "desc"),
+		o.validateSortMode("", o.SortBy, o.SortOrder),
+	)
+}
+
+// businessRuleSortByValues are the sort_by values accepted by the macro and trigger list endpoints
+var businessRuleSortByValues = []string{
+	"alphabetical", "created_at", "updated_at", "usage_1h", "usage_24h", "usage_7d", "usage_30d", "position",
+}
+
 // MacroAPI an interface containing all macro related methods
 type MacroAPI interface {
 	GetMacros(ctx context.Context, opts *MacroListOptions) ([]Macro, Page, error)
@@ -51,6 +95,7 @@ type MacroAPI interface {
 	GetMacrosIterator(ctx context.Context, opts *PaginationOptions) *Iterator[Macro]
 	GetMacrosOBP(ctx context.Context, opts *OBPOptions) ([]Macro, Page, error)
 	GetMacrosCBP(ctx context.Context, opts *CBPOptions) ([]Macro, client.CursorPaginationMeta, error)
+	IterateMacros(ctx context.Context, opts *MacroIteratorOptions) *Iterator[Macro]
 }
 
 // GetMacros get macro list
@@ -77,13 +122,23 @@ func (z *Client) GetMacros(ctx context.Context, opts *MacroListOptions) ([]Macro
 		return nil, Page{}, err
 	}
 
-	err = json.Unmarshal(body, &data)
+	err = z.unmarshal(body, &data)
 	if err != nil {
 		return nil, Page{}, err
 	}
 	return data.Macros, data.Page, nil
 }
 
+// IterateMacros returns an Iterator over all macros
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/macros/#list-macros
+func (z *Client) IterateMacros(ctx context.Context, opts *MacroIteratorOptions) *Iterator[Macro] {
+	if opts == nil {
+		opts = &MacroIteratorOptions{}
+	}
+	return newListIterator(ctx, opts.IteratorOptions, *opts, 0, z.GetMacrosOBP, z.GetMacrosCBP)
+}
+
 // GetMacro gets a specified macro
 //
 // ref: https://developer.zendesk.com/rest_api/docs/support/macros#show-macro
@@ -97,7 +152,7 @@ func (z *Client) GetMacro(ctx context.Context, macroID int64) (Macro, error) {
 		return Macro{}, err
 	}
 
-	err = json.Unmarshal(body, &result)
+	err = z.unmarshal(body, &result)
 	if err != nil {
 		return Macro{}, err
 	}
@@ -114,12 +169,12 @@ func (z *Client) CreateMacro(ctx context.Context, macro Macro) (Macro, error) {
 	}
 	data.Macro = macro
 
-	body, err := z.Post(ctx, "/macros.json", data)
+	body, err := z.Post(ctx, "/macros.json", withExtraFields(data))
 	if err != nil {
 		return Macro{}, err
 	}
 
-	err = json.Unmarshal(body, &result)
+	err = z.unmarshal(body, &result)
 	if err != nil {
 		return Macro{}, err
 	}
@@ -135,12 +190,12 @@ func (z *Client) UpdateMacro(ctx context.Context, macroID int64, macro Macro) (M
 	data.Macro = macro
 
 	path := fmt.Sprintf("/macros/%d.json", macroID)
-	body, err := z.Put(ctx, path, data)
+	body, err := z.Put(ctx, path, withExtraFields(data))
 	if err != nil {
 		return Macro{}, err
 	}
 
-	err = json.Unmarshal(body, &result)
+	err = z.unmarshal(body, &result)
 	if err != nil {
 		return Macro{}, err
 	}
@@ -1,9 +1,9 @@
 // Code generated by Script. DO NOT EDIT.
-// Source: script/codegen/main.go
+// Source: script/codegen/resources.yaml
 //
 // Generated by this command:
 //
-//	go run script/codegen/main.go
+//	go run ./script/codegen
 
 package zendesk
 
@@ -12,6 +12,7 @@ import (
 	"github.com/JacobPotter/go-zendesk/client"
 )
 
+// GetMacrosIterator returns an Iterator over /macros.json
 func (z *Client) GetMacrosIterator(ctx context.Context, opts *PaginationOptions) *Iterator[Macro] {
 	return &Iterator[Macro]{
 		CommonOptions: opts.CommonOptions,
@@ -26,6 +27,7 @@ func (z *Client) GetMacrosIterator(ctx context.Context, opts *PaginationOptions)
 	}
 }
 
+// GetMacrosOBP fetches a page of /macros.json with offset based pagination
 func (z *Client) GetMacrosOBP(ctx context.Context, opts *OBPOptions) ([]Macro, Page, error) {
 	var data struct {
 		Macros []Macro `json:"macros"`
@@ -37,19 +39,19 @@ func (z *Client) GetMacrosOBP(ctx context.Context, opts *OBPOptions) ([]Macro, P
 		tmp = &OBPOptions{}
 	}
 
-	u, err := client.AddOptions("/macros.json", tmp)
-
+	u, err := tmp.addOptions("/macros.json")
 	if err != nil {
 		return nil, Page{}, err
 	}
 
-	err = client.GetData(z, ctx, u, &data)
+	err = z.getData(ctx, u, &data)
 	if err != nil {
 		return nil, Page{}, err
 	}
 	return data.Macros, data.Page, nil
 }
 
+// GetMacrosCBP fetches a page of /macros.json with cursor based pagination
 func (z *Client) GetMacrosCBP(ctx context.Context, opts *CBPOptions) ([]Macro, client.CursorPaginationMeta, error) {
 	var data struct {
 		Macros []Macro                     `json:"macros"`
@@ -61,13 +63,12 @@ func (z *Client) GetMacrosCBP(ctx context.Context, opts *CBPOptions) ([]Macro, c
 		tmp = &CBPOptions{}
 	}
 
-	u, err := client.AddOptions("/macros.json", tmp)
-
+	u, err := tmp.addOptions("/macros.json")
 	if err != nil {
 		return nil, data.Meta, err
 	}
 
-	err = client.GetData(z, ctx, u, &data)
+	err = z.getData(ctx, u, &data)
 	if err != nil {
 		return nil, data.Meta, err
 	}
@@ -0,0 +1,129 @@
+package zendesk
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Nullable is a field of an update which is either unset, explicitly null or set to a value.
+// The zero Nullable is unset: it is left out of the update, so that fields can be cleared with
+// Null without resetting every other field to its zero value.
+//
+//	zendesk.UserPatch{Suspended: zendesk.Some(false), Phone: zendesk.Null[string]()}
+type Nullable[T any] struct {
+	value T
+	set   bool
+	null  bool
+}
+
+// Some returns a Nullable set to v
+func Some[T any](v T) Nullable[T] {
+	return Nullable[T]{value: v, set: true}
+}
+
+// Null returns a Nullable set to null, which clears the field
+func Null[T any]() Nullable[T] {
+	return Nullable[T]{set: true, null: true}
+}
+
+// IsSet reports whether the field is set, to a value or null
+func (n Nullable[T]) IsSet() bool {
+	return n.set
+}
+
+// IsNull reports whether the field is set to null
+func (n Nullable[T]) IsNull() bool {
+	return n.set && n.null
+}
+
+// Get returns the value of the field and whether it is set to a value
+func (n Nullable[T]) Get() (T, bool) {
+	return n.value, n.set && !n.null
+}
+
+// MarshalJSON is marshaller for Nullable. An unset Nullable is marshaled as null; use it in a struct
+// marshaled with marshalPatch to leave it out.
+func (n Nullable[T]) MarshalJSON() ([]byte, error) {
+	if !n.set || n.null {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.value)
+}
+
+// UnmarshalJSON is unmarshaller for Nullable
+func (n *Nullable[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*n = Null[T]()
+		return nil
+	}
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*n = Some(v)
+	return nil
+}
+
+func (n Nullable[T]) isSet() bool {
+	return n.set
+}
+
+// marshalPatch marshals the struct patch leaving out its unset Nullable fields
+func marshalPatch(patch interface{}) ([]byte, error) {
+	fields, err := patchFields(patch)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(fields)
+}
+
+// patchFields returns the fields of the struct patch by JSON name, leaving out its unset Nullable fields
+func patchFields(patch interface{}) (map[string]interface{}, error) {
+	v := reflect.ValueOf(patch)
+	if v.Kind() == reflect.Pointer {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%T is not a struct", patch)
+	}
+
+	fields := map[string]interface{}{}
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if !field.IsExported() || name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		value := v.Field(i).Interface()
+		if n, ok := value.(interface{ isSet() bool }); ok && !n.isSet() {
+			continue
+		}
+		fields[name] = value
+	}
+	return fields, nil
+}
+
+// putPatch sends patch under envelope to path and decodes the updated item
+func putPatch[T any](z *Client, ctx context.Context, path, envelope string, patch json.Marshaler) (T, error) {
+	var item T
+
+	body, err := z.Put(ctx, path, map[string]json.Marshaler{envelope: patch})
+	if err != nil {
+		return item, err
+	}
+
+	var result map[string]json.RawMessage
+	if err := json.Unmarshal(body, &result); err != nil {
+		return item, err
+	}
+	err = json.Unmarshal(result[envelope], &item)
+	return item, err
+}
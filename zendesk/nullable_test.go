@@ -0,0 +1,93 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/JacobPotter/go-zendesk/testhelper"
+)
+
+func TestNullableJSON(t *testing.T) {
+	var n struct {
+		Set   Nullable[int64] `json:"set"`
+		Null  Nullable[int64] `json:"null"`
+		Unset Nullable[int64] `json:"unset"`
+	}
+	if err := json.Unmarshal([]byte(`{"set": 0, "null": null}`), &n); err != nil {
+		t.Fatalf("Failed to unmarshal: %s", err)
+	}
+	if v, ok := n.Set.Get(); !ok || v != 0 {
+		t.Fatalf("Expected set to 0, got %v", n.Set)
+	}
+	if !n.Null.IsNull() || n.Unset.IsSet() {
+		t.Fatalf("Unexpected null %v or unset %v", n.Null, n.Unset)
+	}
+}
+
+func TestPatchUserSendsOnlySetFields(t *testing.T) {
+	var body []byte
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/users/369531345753.json" {
+			t.Fatalf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		body, _ = io.ReadAll(r.Body)
+		_, err := w.Write(testhelper.ReadFixture(t, filepath.Join(http.MethodPut, "user.json")))
+		if err != nil {
+			t.Fatalf("Failed to write fixture: %s", err)
+		}
+	}))
+	c := NewTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	user, err := c.PatchUser(ctx, 369531345753, UserPatch{
+		Suspended: Some(false),
+		Phone:     Null[string](),
+	})
+	if err != nil {
+		t.Fatalf("Failed to patch user: %s", err)
+	}
+	if user.ID == 0 {
+		t.Fatalf("Unexpected user %v", user)
+	}
+	if string(body) != `{"user":{"phone":null,"suspended":false}}` {
+		t.Fatalf("Unexpected request body %s", body)
+	}
+}
+
+func TestTicketUpdatePatch(t *testing.T) {
+	update := NewTicketUpdate().Patch(TicketPatch{
+		AssigneeID: Null[int64](),
+		GroupID:    Some(int64(5)),
+	})
+	b, err := json.Marshal(update)
+	if err != nil {
+		t.Fatalf("Failed to marshal update: %s", err)
+	}
+	if string(b) != `{"assignee_id":null,"group_id":5}` {
+		t.Fatalf("Unexpected update %s", b)
+	}
+}
+
+func TestPatchGroup(t *testing.T) {
+	var body []byte
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ = io.ReadAll(r.Body)
+		_, err := w.Write(testhelper.ReadFixture(t, filepath.Join(http.MethodPut, "groups.json")))
+		if err != nil {
+			t.Fatalf("Failed to write fixture: %s", err)
+		}
+	}))
+	c := NewTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if _, err := c.PatchGroup(ctx, 1, GroupPatch{IsPublic: Some(false)}); err != nil {
+		t.Fatalf("Failed to patch group: %s", err)
+	}
+	if string(body) != `{"group":{"is_public":false}}` {
+		t.Fatalf("Unexpected request body %s", body)
+	}
+}
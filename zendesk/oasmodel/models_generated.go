@@ -0,0 +1,388 @@
+// Code generated by Script. DO NOT EDIT.
+// Source: script/modelgen/oas.yaml
+//
+// Generated by this command:
+//
+//	go run ./script/modelgen
+
+package oasmodel
+
+import "github.com/JacobPotter/go-zendesk/zendesk"
+
+// TicketPriority is an enum generated from the OpenAPI specification
+type TicketPriority string
+
+const (
+	TicketPriorityUrgent TicketPriority = "urgent"
+	TicketPriorityHigh   TicketPriority = "high"
+	TicketPriorityNormal TicketPriority = "normal"
+	TicketPriorityLow    TicketPriority = "low"
+)
+
+// TicketStatus is an enum generated from the OpenAPI specification
+type TicketStatus string
+
+const (
+	TicketStatusNew     TicketStatus = "new"
+	TicketStatusOpen    TicketStatus = "open"
+	TicketStatusPending TicketStatus = "pending"
+	TicketStatusHold    TicketStatus = "hold"
+	TicketStatusSolved  TicketStatus = "solved"
+	TicketStatusClosed  TicketStatus = "closed"
+)
+
+// TicketType is an enum generated from the OpenAPI specification
+type TicketType string
+
+const (
+	TicketTypeProblem  TicketType = "problem"
+	TicketTypeIncident TicketType = "incident"
+	TicketTypeQuestion TicketType = "question"
+	TicketTypeTask     TicketType = "task"
+)
+
+// UserRole is an enum generated from the OpenAPI specification
+type UserRole string
+
+const (
+	UserRoleEndUser UserRole = "end-user"
+	UserRoleAgent   UserRole = "agent"
+	UserRoleAdmin   UserRole = "admin"
+)
+
+// UserTicketRestriction is an enum generated from the OpenAPI specification
+type UserTicketRestriction string
+
+const (
+	UserTicketRestrictionOrganization UserTicketRestriction = "organization"
+	UserTicketRestrictionGroups       UserTicketRestriction = "groups"
+	UserTicketRestrictionAssigned     UserTicketRestriction = "assigned"
+	UserTicketRestrictionRequested    UserTicketRestriction = "requested"
+)
+
+// CustomField is generated from the OpenAPI specification
+//
+// schema: CustomFieldObject
+type CustomField struct {
+	// The id of the custom field
+	ID int64 `json:"id,omitempty"`
+	// The value of the custom field
+	Value interface{} `json:"value,omitempty"`
+}
+
+// Group is generated from the OpenAPI specification
+// A group of agents tickets can be assigned to
+//
+// schema: GroupObject
+type Group struct {
+	// The time the group was created (read-only)
+	CreatedAt *zendesk.Timestamp `json:"created_at,omitempty"`
+	// If the group is the default one for the account (read-only)
+	Default bool `json:"default,omitempty"`
+	// Deleted groups get marked as such (read-only)
+	Deleted bool `json:"deleted,omitempty"`
+	// The description of the group
+	Description string `json:"description,omitempty"`
+	// Automatically assigned when creating groups (read-only)
+	ID int64 `json:"id,omitempty"`
+	// If true, the group is public. If false, the group is private
+	IsPublic bool `json:"is_public,omitempty"`
+	// The name of the group
+	Name string `json:"name,omitempty"`
+	// The time of the last update of the group (read-only)
+	UpdatedAt *zendesk.Timestamp `json:"updated_at,omitempty"`
+	// The API url of the group (read-only)
+	URL string `json:"url,omitempty"`
+}
+
+// Organization is generated from the OpenAPI specification
+// A collection of end users
+//
+// schema: OrganizationObject
+type Organization struct {
+	// The time the organization was created (read-only)
+	CreatedAt *zendesk.Timestamp `json:"created_at,omitempty"`
+	// Any details about the organization, such as the address
+	Details *string `json:"details,omitempty"`
+	// An array of domain names associated with this organization
+	DomainNames []string `json:"domain_names,omitempty"`
+	// A unique external id to associate organizations to an external record
+	ExternalID *string `json:"external_id,omitempty"`
+	// New tickets from users in this organization are automatically put in this group
+	GroupID *int64 `json:"group_id,omitempty"`
+	// Automatically assigned when the organization is created
+	ID int64 `json:"id,omitempty"`
+	// A unique name for the organization
+	Name string `json:"name,omitempty"`
+	// Any notes you have about the organization
+	Notes *string `json:"notes,omitempty"`
+	// Custom fields for this organization
+	OrganizationFields map[string]interface{} `json:"organization_fields,omitempty"`
+	// End users in this organization are able to comment on each other's tickets
+	SharedComments bool `json:"shared_comments,omitempty"`
+	// End users in this organization are able to see each other's tickets
+	SharedTickets bool `json:"shared_tickets,omitempty"`
+	// The tags of the organization
+	Tags []string `json:"tags,omitempty"`
+	// The time of the last update of the organization (read-only)
+	UpdatedAt *zendesk.Timestamp `json:"updated_at,omitempty"`
+	// The API url of this organization (read-only)
+	URL string `json:"url,omitempty"`
+}
+
+// Ticket is generated from the OpenAPI specification
+// A ticket in Zendesk Support
+//
+// schema: TicketObject
+type Ticket struct {
+	// Permission for agents to add add attachments to a comment. Defaults to true (read-only)
+	AllowAttachments bool `json:"allow_attachments,omitempty"`
+	// Is false if channelback is disabled, true otherwise. Only applicable for channels framework ticket (read-only)
+	AllowChannelback bool `json:"allow_channelback,omitempty"`
+	// Write only. The email address of the agent to assign the ticket to
+	AssigneeEmail string `json:"assignee_email,omitempty"`
+	// The agent currently assigned to the ticket
+	AssigneeID *int64 `json:"assignee_id,omitempty"`
+	// Write only. An array of the IDs of attribute values to be associated with the ticket
+	AttributeValueIDs []int64 `json:"attribute_value_ids,omitempty"`
+	// The id of the brand this ticket is associated with
+	BrandID int64 `json:"brand_id,omitempty"`
+	// The ids of users currently CC'ed on the ticket
+	CollaboratorIDs []int64 `json:"collaborator_ids,omitempty"`
+	// When this record was created (read-only)
+	CreatedAt *zendesk.Timestamp `json:"created_at,omitempty"`
+	// Custom fields for the ticket
+	CustomFields []CustomField `json:"custom_fields,omitempty"`
+	// The custom ticket status id of the ticket
+	CustomStatusID int64 `json:"custom_status_id,omitempty"`
+	// Read-only first comment on the ticket. When creating a ticket, use comment to set the description (read-only)
+	Description string `json:"description,omitempty"`
+	// If this is a ticket of type "task" it has a due date. Due date format uses ISO 8601 format
+	DueAt *zendesk.Timestamp `json:"due_at,omitempty"`
+	// The ids of agents or end users currently CC'ed on the ticket
+	EmailCCIDs []int64 `json:"email_cc_ids,omitempty"`
+	// An id you can use to link Zendesk Support tickets to local records
+	ExternalID string `json:"external_id,omitempty"`
+	// The ids of agents currently following the ticket
+	FollowerIDs []int64 `json:"follower_ids,omitempty"`
+	// The ids of the followups created from this ticket. Ids are only visible once the ticket is closed (read-only)
+	FollowupIDs []int64 `json:"followup_ids,omitempty"`
+	// The topic in the Zendesk Web portal this ticket originated from, if any (read-only)
+	ForumTopicID int64 `json:"forum_topic_id,omitempty"`
+	// If true, the ticket's via type is a messaging channel (read-only)
+	FromMessagingChannel bool `json:"from_messaging_channel,omitempty"`
+	// The group this ticket is assigned to
+	GroupID int64 `json:"group_id,omitempty"`
+	// Is true if a ticket is a problem type and has one or more incidents linked to it (read-only)
+	HasIncidents bool `json:"has_incidents,omitempty"`
+	// Automatically assigned when the ticket is created (read-only)
+	ID int64 `json:"id,omitempty"`
+	// Is true if any comments are public, false otherwise (read-only)
+	IsPublic bool `json:"is_public,omitempty"`
+	// Write only. List of macro IDs to be recorded in the ticket audit
+	MacroIDs []int64 `json:"macro_ids,omitempty"`
+	// The organization of the requester
+	OrganizationID int64 `json:"organization_id,omitempty"`
+	// The urgency with which the ticket should be addressed
+	Priority TicketPriority `json:"priority,omitempty"`
+	// For tickets of type "incident", the ID of the problem the incident is linked to
+	ProblemID int64 `json:"problem_id,omitempty"`
+	// The dynamic content placeholder, if present, or the "subject" value, if not
+	RawSubject string `json:"raw_subject,omitempty"`
+	// The original recipient e-mail address of the ticket
+	Recipient string `json:"recipient,omitempty"`
+	// The user who requested this ticket
+	RequesterID int64 `json:"requester_id,omitempty"`
+	// Write only. Optional boolean. When true and an update_stamp date is included, protects against ticket update collisions
+	SafeUpdate bool `json:"safe_update,omitempty"`
+	// The satisfaction rating of the ticket, if it exists, or the state of satisfaction, "offered" or "unoffered" (read-only)
+	SatisfactionRating map[string]interface{} `json:"satisfaction_rating,omitempty"`
+	// The ids of the sharing agreements used for this ticket (read-only)
+	SharingAgreementIDs []int64 `json:"sharing_agreement_ids,omitempty"`
+	// The state of the ticket
+	Status TicketStatus `json:"status,omitempty"`
+	// The value of the subject field for this ticket
+	Subject string `json:"subject,omitempty"`
+	// The user who submitted the ticket. The submitter always becomes the author of the first comment on the ticket
+	SubmitterID int64 `json:"submitter_id,omitempty"`
+	// The array of tags applied to this ticket
+	Tags []string `json:"tags,omitempty"`
+	// Enterprise only. The id of the ticket form to render for the ticket
+	TicketFormID int64 `json:"ticket_form_id,omitempty"`
+	// The type of this ticket
+	Type TicketType `json:"type,omitempty"`
+	// When this record last got updated (read-only)
+	UpdatedAt *zendesk.Timestamp `json:"updated_at,omitempty"`
+	// Write only. Datetime of last update received from API. See the safe_update property
+	UpdatedStamp *zendesk.Timestamp `json:"updated_stamp,omitempty"`
+	// The API url of this ticket (read-only)
+	URL string `json:"url,omitempty"`
+	// An object explaining how the ticket was created
+	Via Via `json:"via,omitempty"`
+	// POST requests only. The id of a closed ticket when creating a follow-up ticket
+	ViaFollowupSourceID int64 `json:"via_followup_source_id,omitempty"`
+}
+
+// TicketMetric is generated from the OpenAPI specification
+// The metrics of a ticket
+//
+// schema: TicketMetricObject
+type TicketMetric struct {
+	// A duration in minutes, in business hours and in calendar hours
+	AgentWaitTimeInMinutes TicketMetricTime `json:"agent_wait_time_in_minutes,omitempty"`
+	// When the ticket was assigned (read-only)
+	AssignedAt *zendesk.Timestamp `json:"assigned_at,omitempty"`
+	// Number of assignees the ticket had (read-only)
+	AssigneeStations int64 `json:"assignee_stations,omitempty"`
+	// When the assignee last updated the ticket (read-only)
+	AssigneeUpdatedAt *zendesk.Timestamp `json:"assignee_updated_at,omitempty"`
+	// When the record was created (read-only)
+	CreatedAt *zendesk.Timestamp `json:"created_at,omitempty"`
+	// The date and time the ticket's custom status was last updated (read-only)
+	CustomStatusUpdatedAt *zendesk.Timestamp `json:"custom_status_updated_at,omitempty"`
+	// A duration in minutes, in business hours and in calendar hours
+	FirstResolutionTimeInMinutes TicketMetricTime `json:"first_resolution_time_in_minutes,omitempty"`
+	// A duration in minutes, in business hours and in calendar hours
+	FullResolutionTimeInMinutes TicketMetricTime `json:"full_resolution_time_in_minutes,omitempty"`
+	// Number of groups the ticket passed through (read-only)
+	GroupStations int64 `json:"group_stations,omitempty"`
+	// Automatically assigned when the client is created (read-only)
+	ID int64 `json:"id,omitempty"`
+	// When the ticket was initially assigned (read-only)
+	InitiallyAssignedAt *zendesk.Timestamp `json:"initially_assigned_at,omitempty"`
+	// When the latest comment was added (read-only)
+	LatestCommentAddedAt *zendesk.Timestamp `json:"latest_comment_added_at,omitempty"`
+	// A duration in minutes, in business hours and in calendar hours
+	OnHoldTimeInMinutes TicketMetricTime `json:"on_hold_time_in_minutes,omitempty"`
+	// Total number of times the ticket was reopened (read-only)
+	Reopens int64 `json:"reopens,omitempty"`
+	// The number of public replies added to a ticket by an agent (read-only)
+	Replies int64 `json:"replies,omitempty"`
+	// A duration in minutes, in business hours and in calendar hours
+	ReplyTimeInMinutes TicketMetricTime `json:"reply_time_in_minutes,omitempty"`
+	// When the requester last updated the ticket (read-only)
+	RequesterUpdatedAt *zendesk.Timestamp `json:"requester_updated_at,omitempty"`
+	// A duration in minutes, in business hours and in calendar hours
+	RequesterWaitTimeInMinutes TicketMetricTime `json:"requester_wait_time_in_minutes,omitempty"`
+	// When the ticket was solved (read-only)
+	SolvedAt *zendesk.Timestamp `json:"solved_at,omitempty"`
+	// When the status of the ticket was last updated (read-only)
+	StatusUpdatedAt *zendesk.Timestamp `json:"status_updated_at,omitempty"`
+	// Id of the associated ticket (read-only)
+	TicketID int64 `json:"ticket_id,omitempty"`
+	// When the record was last updated (read-only)
+	UpdatedAt *zendesk.Timestamp `json:"updated_at,omitempty"`
+	// The API url of the ticket metric (read-only)
+	URL string `json:"url,omitempty"`
+}
+
+// TicketMetricTime is generated from the OpenAPI specification
+// A duration in minutes, in business hours and in calendar hours
+//
+// schema: TicketMetricTimeObject
+type TicketMetricTime struct {
+	// Time in business hours
+	Business int64 `json:"business,omitempty"`
+	// Time in calendar hours
+	Calendar int64 `json:"calendar,omitempty"`
+}
+
+// User is generated from the OpenAPI specification
+// A user of the account, an end user, an agent or an admin
+//
+// schema: UserObject
+type User struct {
+	// false if the user has been deleted (read-only)
+	Active bool `json:"active,omitempty"`
+	// An alias displayed to end users
+	Alias string `json:"alias,omitempty"`
+	// Whether or not the user is a chat-only agent (read-only)
+	ChatOnly bool `json:"chat_only,omitempty"`
+	// The time the user was created (read-only)
+	CreatedAt *zendesk.Timestamp `json:"created_at,omitempty"`
+	// A custom role if the user is an agent on the Enterprise plan or above
+	CustomRoleID *int64 `json:"custom_role_id,omitempty"`
+	// The id of the user's default group
+	DefaultGroupID int64 `json:"default_group_id,omitempty"`
+	// Any details you want to store about the user, such as an address
+	Details string `json:"details,omitempty"`
+	// The user's primary email address
+	Email string `json:"email,omitempty"`
+	// A unique identifier from another system
+	ExternalID *string `json:"external_id,omitempty"`
+	// The time zone for the user (read-only)
+	IanaTimeZone string `json:"iana_time_zone,omitempty"`
+	// Automatically assigned when the user is created (read-only)
+	ID int64 `json:"id,omitempty"`
+	// Last time the user signed in to Zendesk Support or made an API request using an API token or basic authentication (read-only)
+	LastLoginAt *zendesk.Timestamp `json:"last_login_at,omitempty"`
+	// The user's locale
+	Locale string `json:"locale,omitempty"`
+	// The user's language identifier
+	LocaleID int64 `json:"locale_id,omitempty"`
+	// Designates whether the user has forum moderation capabilities
+	Moderator bool `json:"moderator,omitempty"`
+	// The user's name
+	Name string `json:"name,omitempty"`
+	// Any notes you want to store about the user
+	Notes string `json:"notes,omitempty"`
+	// true if the user can only create private comments
+	OnlyPrivateComments bool `json:"only_private_comments,omitempty"`
+	// The id of the user's organization
+	OrganizationID *int64 `json:"organization_id,omitempty"`
+	// The user's primary phone number
+	Phone *string `json:"phone,omitempty"`
+	// A URL pointing to the user's profile picture
+	RemotePhotoURL string `json:"remote_photo_url,omitempty"`
+	// If the agent has any restrictions; false for admins and unrestricted agents, true for other agents
+	RestrictedAgent bool `json:"restricted_agent,omitempty"`
+	// The user's role
+	Role UserRole `json:"role,omitempty"`
+	// The user's role id. 0 for a custom agent, 1 for a light agent, 2 for a chat agent, and so on (read-only)
+	RoleType *int64 `json:"role_type,omitempty"`
+	// If the user is shared from a different Zendesk Support instance (read-only)
+	Shared bool `json:"shared,omitempty"`
+	// If the user is a shared agent from a different Zendesk Support instance (read-only)
+	SharedAgent bool `json:"shared_agent,omitempty"`
+	// Whether the phone number is shared or not
+	SharedPhoneNumber *bool `json:"shared_phone_number,omitempty"`
+	// The user's signature. Only agents and admins can have signatures
+	Signature string `json:"signature,omitempty"`
+	// If the agent is suspended. Tickets from suspended users are also suspended
+	Suspended bool `json:"suspended,omitempty"`
+	// The user's tags. Only present if your account has user tagging enabled
+	Tags []string `json:"tags,omitempty"`
+	// Specifies which tickets the user has access to
+	TicketRestriction *UserTicketRestriction `json:"ticket_restriction,omitempty"`
+	// The user's time zone
+	TimeZone string `json:"time_zone,omitempty"`
+	// If two factor authentication is enabled (read-only)
+	TwoFactorAuthEnabled *bool `json:"two_factor_auth_enabled,omitempty"`
+	// The time the user was last updated (read-only)
+	UpdatedAt *zendesk.Timestamp `json:"updated_at,omitempty"`
+	// The user's API url (read-only)
+	URL string `json:"url,omitempty"`
+	// Values of custom fields in the user's profile
+	UserFields map[string]interface{} `json:"user_fields,omitempty"`
+	// Any of the user's identities is verified
+	Verified bool `json:"verified,omitempty"`
+}
+
+// Via is generated from the OpenAPI specification
+// An object explaining how the ticket was created
+//
+// schema: ViaObject
+type Via struct {
+	// This tells you how the ticket or event was created
+	Channel string `json:"channel,omitempty"`
+	// For some channels a source object gives more information about how or why the ticket or event was created
+	Source ViaSource `json:"source,omitempty"`
+}
+
+// ViaSource is generated from the OpenAPI specification
+// For some channels a source object gives more information about how or why the ticket or event was created
+type ViaSource struct {
+	From map[string]interface{} `json:"from,omitempty"`
+	Rel  *string                `json:"rel,omitempty"`
+	To   map[string]interface{} `json:"to,omitempty"`
+}
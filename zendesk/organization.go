@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"fmt"
 	client2 "github.com/JacobPotter/go-zendesk/client"
-	"time"
 )
 
 // Organization is struct for organization payload
@@ -22,8 +21,8 @@ type Organization struct {
 	SharedComments     bool                   `json:"shared_comments"`
 	Tags               []string               `json:"tags"`
 	Notes              string                 `json:"notes,omitempty"`
-	CreatedAt          time.Time              `json:"created_at,omitempty"`
-	UpdatedAt          time.Time              `json:"updated_at,omitempty"`
+	CreatedAt          *Timestamp             `json:"created_at,omitempty"`
+	UpdatedAt          *Timestamp             `json:"updated_at,omitempty"`
 	OrganizationFields map[string]interface{} `json:"organization_fields,omitempty"`
 }
 
@@ -39,8 +38,10 @@ type OrganizationAPI interface {
 	GetOrganizations(ctx context.Context, opts *OrganizationListOptions) ([]Organization, Page, error)
 	CreateOrganization(ctx context.Context, org Organization) (Organization, error)
 	GetOrganization(ctx context.Context, orgID int64) (Organization, error)
+	GetOrganizationWithSideloads(ctx context.Context, orgID int64, include string) (Organization, Sideloads, error)
 	GetOrganizationByExternalID(ctx context.Context, externalID string) ([]Organization, Page, error)
 	UpdateOrganization(ctx context.Context, orgID int64, org Organization) (Organization, error)
+	PatchOrganization(ctx context.Context, orgID int64, patch OrganizationPatch) (Organization, error)
 	DeleteOrganization(ctx context.Context, orgID int64) error
 	GetOrganizationsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[Organization]
 	GetOrganizationsOBP(ctx context.Context, opts *OBPOptions) ([]Organization, Page, error)
@@ -120,6 +121,17 @@ func (z *Client) GetOrganization(ctx context.Context, orgID int64) (Organization
 	return result.Organization, err
 }
 
+// GetOrganizationWithSideloads gets a specified organization together with the side-loads listed in include,
+// a comma separated list of users and groups
+//
+// ref: https://developer.zendesk.com/documentation/ticketing/using-the-zendesk-api/side_loading/
+func (z *Client) GetOrganizationWithSideloads(
+	ctx context.Context, orgID int64, include string,
+) (Organization, Sideloads, error) {
+	return getWithSideloads[Organization](z, ctx, fmt.Sprintf("/organizations/%d.json", orgID), "organization",
+		include, organizationSideloads...)
+}
+
 // GetOrganizationByExternalID gets a specified organization by external ID
 // ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organizations/#search-organizations-by-external-id
 func (z *Client) GetOrganizationByExternalID(ctx context.Context, externalID string) ([]Organization, Page, error) {
@@ -142,6 +154,7 @@ func (z *Client) GetOrganizationByExternalID(ctx context.Context, externalID str
 }
 
 // UpdateOrganization updates a organization with the specified organization
+// Fields with a zero value are left out, use PatchOrganization to set them to false, empty or null.
 // ref: https://developer.zendesk.com/rest_api/docs/support/organizations#update-organization
 func (z *Client) UpdateOrganization(ctx context.Context, orgID int64, org Organization) (Organization, error) {
 	var result, data struct {
@@ -173,3 +186,31 @@ func (z *Client) DeleteOrganization(ctx context.Context, orgID int64) error {
 
 	return nil
 }
+
+// OrganizationPatch is a partial update of an organization. Only the fields which are set are sent,
+// and fields set to Null are cleared.
+type OrganizationPatch struct {
+	Name               Nullable[string]                 `json:"name"`
+	Details            Nullable[string]                 `json:"details"`
+	Notes              Nullable[string]                 `json:"notes"`
+	ExternalID         Nullable[string]                 `json:"external_id"`
+	GroupID            Nullable[int64]                  `json:"group_id"`
+	SharedTickets      Nullable[bool]                   `json:"shared_tickets"`
+	SharedComments     Nullable[bool]                   `json:"shared_comments"`
+	DomainNames        Nullable[[]string]               `json:"domain_names"`
+	Tags               Nullable[[]string]               `json:"tags"`
+	OrganizationFields Nullable[map[string]interface{}] `json:"organization_fields"`
+}
+
+// MarshalJSON is marshaller for OrganizationPatch, which leaves out the fields which are not set
+func (p OrganizationPatch) MarshalJSON() ([]byte, error) {
+	return marshalPatch(p)
+}
+
+// PatchOrganization updates the fields set in patch. Unlike UpdateOrganization, fields can be set to
+// their zero value or cleared.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organizations/#update-organization
+func (z *Client) PatchOrganization(ctx context.Context, orgID int64, patch OrganizationPatch) (Organization, error) {
+	return putPatch[Organization](z, ctx, fmt.Sprintf("/organizations/%d.json", orgID), "organization", patch)
+}
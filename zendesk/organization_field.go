@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/JacobPotter/go-zendesk/client"
-	"time"
 )
 
 // OrganizationField represents the Organization Custom field structure
@@ -26,8 +25,8 @@ type OrganizationField struct {
 	RegexpForValidation    string              `json:"regexp_for_validation,omitempty"`
 	System                 bool                `json:"system,omitempty"`
 	Tag                    string              `json:"tag,omitempty"`
-	CreatedAt              *time.Time          `json:"created_at,omitempty"`
-	UpdatedAt              *time.Time          `json:"updated_at,omitempty"`
+	CreatedAt              *Timestamp          `json:"created_at,omitempty"`
+	UpdatedAt              *Timestamp          `json:"updated_at,omitempty"`
 }
 
 // OrganizationFieldAPI an interface containing all the organization field related zendesk methods
@@ -1,9 +1,9 @@
 // Code generated by Script. DO NOT EDIT.
-// Source: script/codegen/main.go
+// Source: script/codegen/resources.yaml
 //
 // Generated by this command:
 //
-//	go run script/codegen/main.go
+//	go run ./script/codegen
 
 package zendesk
 
@@ -12,6 +12,7 @@ import (
 	"github.com/JacobPotter/go-zendesk/client"
 )
 
+// GetOrganizationFieldsIterator returns an Iterator over /organization_fields.json
 func (z *Client) GetOrganizationFieldsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[OrganizationField] {
 	return &Iterator[OrganizationField]{
 		CommonOptions: opts.CommonOptions,
@@ -26,6 +27,7 @@ func (z *Client) GetOrganizationFieldsIterator(ctx context.Context, opts *Pagina
 	}
 }
 
+// GetOrganizationFieldsOBP fetches a page of /organization_fields.json with offset based pagination
 func (z *Client) GetOrganizationFieldsOBP(ctx context.Context, opts *OBPOptions) ([]OrganizationField, Page, error) {
 	var data struct {
 		OrganizationFields []OrganizationField `json:"organization_fields"`
@@ -37,19 +39,19 @@ func (z *Client) GetOrganizationFieldsOBP(ctx context.Context, opts *OBPOptions)
 		tmp = &OBPOptions{}
 	}
 
-	u, err := client.AddOptions("/organization_fields.json", tmp)
-
+	u, err := tmp.addOptions("/organization_fields.json")
 	if err != nil {
 		return nil, Page{}, err
 	}
 
-	err = client.GetData(z, ctx, u, &data)
+	err = z.getData(ctx, u, &data)
 	if err != nil {
 		return nil, Page{}, err
 	}
 	return data.OrganizationFields, data.Page, nil
 }
 
+// GetOrganizationFieldsCBP fetches a page of /organization_fields.json with cursor based pagination
 func (z *Client) GetOrganizationFieldsCBP(ctx context.Context, opts *CBPOptions) ([]OrganizationField, client.CursorPaginationMeta, error) {
 	var data struct {
 		OrganizationFields []OrganizationField         `json:"organization_fields"`
@@ -61,15 +63,32 @@ func (z *Client) GetOrganizationFieldsCBP(ctx context.Context, opts *CBPOptions)
 		tmp = &CBPOptions{}
 	}
 
-	u, err := client.AddOptions("/organization_fields.json", tmp)
-
+	u, err := tmp.addOptions("/organization_fields.json")
 	if err != nil {
 		return nil, data.Meta, err
 	}
 
-	err = client.GetData(z, ctx, u, &data)
+	err = z.getData(ctx, u, &data)
 	if err != nil {
 		return nil, data.Meta, err
 	}
 	return data.OrganizationFields, data.Meta, nil
 }
+
+// OrganizationFieldIteratorOptions are the options accepted by IterateOrganizationFields
+type OrganizationFieldIteratorOptions struct {
+	IteratorOptions
+}
+
+// Validate checks the options of OrganizationFieldIteratorOptions
+func (o OrganizationFieldIteratorOptions) Validate() error {
+	return nil
+}
+
+// IterateOrganizationFields returns an Iterator over /organization_fields.json
+func (z *Client) IterateOrganizationFields(ctx context.Context, opts *OrganizationFieldIteratorOptions) *Iterator[OrganizationField] {
+	if opts == nil {
+		opts = &OrganizationFieldIteratorOptions{}
+	}
+	return newListIterator(ctx, opts.IteratorOptions, *opts, 0, z.GetOrganizationFieldsOBP, z.GetOrganizationFieldsCBP)
+}
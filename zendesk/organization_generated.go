@@ -1,9 +1,9 @@
 // Code generated by Script. DO NOT EDIT.
-// Source: script/codegen/main.go
+// Source: script/codegen/resources.yaml
 //
 // Generated by this command:
 //
-//	go run script/codegen/main.go
+//	go run ./script/codegen
 
 package zendesk
 
@@ -12,6 +12,7 @@ import (
 	"github.com/JacobPotter/go-zendesk/client"
 )
 
+// GetOrganizationsIterator returns an Iterator over /organizations.json
 func (z *Client) GetOrganizationsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[Organization] {
 	return &Iterator[Organization]{
 		CommonOptions: opts.CommonOptions,
@@ -26,6 +27,7 @@ func (z *Client) GetOrganizationsIterator(ctx context.Context, opts *PaginationO
 	}
 }
 
+// GetOrganizationsOBP fetches a page of /organizations.json with offset based pagination
 func (z *Client) GetOrganizationsOBP(ctx context.Context, opts *OBPOptions) ([]Organization, Page, error) {
 	var data struct {
 		Organizations []Organization `json:"organizations"`
@@ -37,19 +39,19 @@ func (z *Client) GetOrganizationsOBP(ctx context.Context, opts *OBPOptions) ([]O
 		tmp = &OBPOptions{}
 	}
 
-	u, err := client.AddOptions("/organizations.json", tmp)
-
+	u, err := tmp.addOptions("/organizations.json")
 	if err != nil {
 		return nil, Page{}, err
 	}
 
-	err = client.GetData(z, ctx, u, &data)
+	err = z.getData(ctx, u, &data)
 	if err != nil {
 		return nil, Page{}, err
 	}
 	return data.Organizations, data.Page, nil
 }
 
+// GetOrganizationsCBP fetches a page of /organizations.json with cursor based pagination
 func (z *Client) GetOrganizationsCBP(ctx context.Context, opts *CBPOptions) ([]Organization, client.CursorPaginationMeta, error) {
 	var data struct {
 		Organizations []Organization              `json:"organizations"`
@@ -61,15 +63,40 @@ func (z *Client) GetOrganizationsCBP(ctx context.Context, opts *CBPOptions) ([]O
 		tmp = &CBPOptions{}
 	}
 
-	u, err := client.AddOptions("/organizations.json", tmp)
-
+	u, err := tmp.addOptions("/organizations.json")
 	if err != nil {
 		return nil, data.Meta, err
 	}
 
-	err = client.GetData(z, ctx, u, &data)
+	err = z.getData(ctx, u, &data)
 	if err != nil {
 		return nil, data.Meta, err
 	}
 	return data.Organizations, data.Meta, nil
 }
+
+// OrganizationIteratorOptions are the options accepted by IterateOrganizations
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organizations/
+type OrganizationIteratorOptions struct {
+	IteratorOptions
+
+	// Include can take a comma separated list of users, groups.
+	// The side-loads of each page are returned by Iterator.Sideloads.
+	Include string `url:"include,omitempty"`
+}
+
+// Validate checks the options of OrganizationIteratorOptions
+func (o OrganizationIteratorOptions) Validate() error {
+	return validateInclude(o.Include, "users", "groups")
+}
+
+// IterateOrganizations returns an Iterator over /organizations.json
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/organizations/organizations/
+func (z *Client) IterateOrganizations(ctx context.Context, opts *OrganizationIteratorOptions) *Iterator[Organization] {
+	if opts == nil {
+		opts = &OrganizationIteratorOptions{}
+	}
+	return newSideloadIterator[Organization](ctx, z, opts.IteratorOptions, *opts, "/organizations.json", "organizations")
+}
@@ -5,21 +5,20 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/JacobPotter/go-zendesk/client"
-	"time"
 )
 
 type (
 	// OrganizationMembership is struct for organization membership payload
 	// https://developer.zendesk.com/api-reference/ticketing/organizations/organization_memberships/
 	OrganizationMembership struct {
-		ID             int64     `json:"id,omitempty"`
-		URL            string    `json:"url,omitempty"`
-		UserID         int64     `json:"user_id"`
-		OrganizationID int64     `json:"organization_id"`
-		Default        bool      `json:"default"`
-		Name           string    `json:"organization_name"`
-		CreatedAt      time.Time `json:"created_at,omitempty"`
-		UpdatedAt      time.Time `json:"updated_at,omitempty"`
+		ID             int64      `json:"id,omitempty"`
+		URL            string     `json:"url,omitempty"`
+		UserID         int64      `json:"user_id"`
+		OrganizationID int64      `json:"organization_id"`
+		Default        bool       `json:"default"`
+		Name           string     `json:"organization_name"`
+		CreatedAt      *Timestamp `json:"created_at,omitempty"`
+		UpdatedAt      *Timestamp `json:"updated_at,omitempty"`
 	}
 
 	// OrganizationMembershipListOptions is a struct for options for organization membership list
@@ -1,9 +1,9 @@
 // Code generated by Script. DO NOT EDIT.
-// Source: script/codegen/main.go
+// Source: script/codegen/resources.yaml
 //
 // Generated by this command:
 //
-//	go run script/codegen/main.go
+//	go run ./script/codegen
 
 package zendesk
 
@@ -12,6 +12,7 @@ import (
 	"github.com/JacobPotter/go-zendesk/client"
 )
 
+// GetOrganizationMembershipsIterator returns an Iterator over /organization_memberships.json
 func (z *Client) GetOrganizationMembershipsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[OrganizationMembership] {
 	return &Iterator[OrganizationMembership]{
 		CommonOptions: opts.CommonOptions,
@@ -26,6 +27,7 @@ func (z *Client) GetOrganizationMembershipsIterator(ctx context.Context, opts *P
 	}
 }
 
+// GetOrganizationMembershipsOBP fetches a page of /organization_memberships.json with offset based pagination
 func (z *Client) GetOrganizationMembershipsOBP(ctx context.Context, opts *OBPOptions) ([]OrganizationMembership, Page, error) {
 	var data struct {
 		OrganizationMemberships []OrganizationMembership `json:"organization_memberships"`
@@ -37,19 +39,19 @@ func (z *Client) GetOrganizationMembershipsOBP(ctx context.Context, opts *OBPOpt
 		tmp = &OBPOptions{}
 	}
 
-	u, err := client.AddOptions("/organization_memberships.json", tmp)
-
+	u, err := tmp.addOptions("/organization_memberships.json")
 	if err != nil {
 		return nil, Page{}, err
 	}
 
-	err = client.GetData(z, ctx, u, &data)
+	err = z.getData(ctx, u, &data)
 	if err != nil {
 		return nil, Page{}, err
 	}
 	return data.OrganizationMemberships, data.Page, nil
 }
 
+// GetOrganizationMembershipsCBP fetches a page of /organization_memberships.json with cursor based pagination
 func (z *Client) GetOrganizationMembershipsCBP(ctx context.Context, opts *CBPOptions) ([]OrganizationMembership, client.CursorPaginationMeta, error) {
 	var data struct {
 		OrganizationMemberships []OrganizationMembership    `json:"organization_memberships"`
@@ -61,13 +63,12 @@ func (z *Client) GetOrganizationMembershipsCBP(ctx context.Context, opts *CBPOpt
 		tmp = &CBPOptions{}
 	}
 
-	u, err := client.AddOptions("/organization_memberships.json", tmp)
-
+	u, err := tmp.addOptions("/organization_memberships.json")
 	if err != nil {
 		return nil, data.Meta, err
 	}
 
-	err = client.GetData(z, ctx, u, &data)
+	err = z.getData(ctx, u, &data)
 	if err != nil {
 		return nil, data.Meta, err
 	}
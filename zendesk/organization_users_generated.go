@@ -1,9 +1,9 @@
 // Code generated by Script. DO NOT EDIT.
-// Source: script/codegen/main.go
+// Source: script/codegen/resources.yaml
 //
 // Generated by this command:
 //
-//	go run script/codegen/main.go
+//	go run ./script/codegen
 
 package zendesk
 
@@ -13,6 +13,7 @@ import (
 	"github.com/JacobPotter/go-zendesk/client"
 )
 
+// GetOrganizationUsersIterator returns an Iterator over /organizations/%d/users.json
 func (z *Client) GetOrganizationUsersIterator(ctx context.Context, opts *PaginationOptions) *Iterator[User] {
 	return &Iterator[User]{
 		CommonOptions: opts.CommonOptions,
@@ -27,9 +28,10 @@ func (z *Client) GetOrganizationUsersIterator(ctx context.Context, opts *Paginat
 	}
 }
 
+// GetOrganizationUsersOBP fetches a page of /organizations/%d/users.json with offset based pagination
 func (z *Client) GetOrganizationUsersOBP(ctx context.Context, opts *OBPOptions) ([]User, Page, error) {
 	var data struct {
-		Users []User `json:"users"`
+		OrganizationUsers []User `json:"users"`
 		Page
 	}
 
@@ -39,23 +41,23 @@ func (z *Client) GetOrganizationUsersOBP(ctx context.Context, opts *OBPOptions)
 	}
 
 	path := fmt.Sprintf("/organizations/%d/users.json", tmp.Id)
-	u, err := client.AddOptions(path, tmp)
-
+	u, err := tmp.addOptions(path)
 	if err != nil {
 		return nil, Page{}, err
 	}
 
-	err = client.GetData(z, ctx, u, &data)
+	err = z.getData(ctx, u, &data)
 	if err != nil {
 		return nil, Page{}, err
 	}
-	return data.Users, data.Page, nil
+	return data.OrganizationUsers, data.Page, nil
 }
 
+// GetOrganizationUsersCBP fetches a page of /organizations/%d/users.json with cursor based pagination
 func (z *Client) GetOrganizationUsersCBP(ctx context.Context, opts *CBPOptions) ([]User, client.CursorPaginationMeta, error) {
 	var data struct {
-		Users []User                      `json:"users"`
-		Meta  client.CursorPaginationMeta `json:"meta"`
+		OrganizationUsers []User                      `json:"users"`
+		Meta              client.CursorPaginationMeta `json:"meta"`
 	}
 
 	tmp := opts
@@ -64,15 +66,14 @@ func (z *Client) GetOrganizationUsersCBP(ctx context.Context, opts *CBPOptions)
 	}
 
 	path := fmt.Sprintf("/organizations/%d/users.json", tmp.Id)
-	u, err := client.AddOptions(path, tmp)
-
+	u, err := tmp.addOptions(path)
 	if err != nil {
 		return nil, data.Meta, err
 	}
 
-	err = client.GetData(z, ctx, u, &data)
+	err = z.getData(ctx, u, &data)
 	if err != nil {
 		return nil, data.Meta, err
 	}
-	return data.Users, data.Meta, nil
+	return data.OrganizationUsers, data.Meta, nil
 }
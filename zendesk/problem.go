@@ -0,0 +1,128 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// TicketRelated is the information about the tickets and other items related to a ticket
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/tickets/#ticket-related-information
+type TicketRelated struct {
+	TopicID           int64   `json:"topic_id,omitempty"`
+	JiraIssueIDs      []int64 `json:"jira_issue_ids,omitempty"`
+	FollowupSourceIDs []int64 `json:"followup_source_ids,omitempty"`
+	FromArchive       bool    `json:"from_archive"`
+
+	// Incidents is the number of incidents linked to the ticket, if it is a problem
+	Incidents int64 `json:"incidents"`
+
+	Twitter struct {
+		HandleID int64                  `json:"handle_id,omitempty"`
+		Profile  map[string]interface{} `json:"profile,omitempty"`
+		Direct   bool                   `json:"direct"`
+	} `json:"twitter"`
+}
+
+// ProblemAPI an interface containing the methods linking problems and their incidents
+type ProblemAPI interface {
+	ListProblems(ctx context.Context) ([]Ticket, error)
+	AutocompleteProblems(ctx context.Context, text string) ([]Ticket, error)
+	GetTicketRelated(ctx context.Context, ticketID int64) (TicketRelated, error)
+	LinkIncidents(ctx context.Context, problemID int64, incidentIDs []int64) ([]BulkJob, error)
+	SolveProblem(ctx context.Context, problemID int64, comment TicketComment, opts *JobWaitOptions) (Ticket, []BulkJob, error)
+}
+
+// ListProblems returns all the problem tickets. Use IterateProblems to fetch them page by page.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/ticket-problems/#list-ticket-problems
+func (z *Client) ListProblems(ctx context.Context) ([]Ticket, error) {
+	return collect(z.IterateProblems(ctx, nil))
+}
+
+// AutocompleteProblems returns the problems whose subject contains text, which must be at least 2 characters
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/ticket-problems/#autocomplete-problems
+func (z *Client) AutocompleteProblems(ctx context.Context, text string) ([]Ticket, error) {
+	var result struct {
+		Tickets []Ticket `json:"tickets"`
+	}
+
+	body, err := z.Post(ctx, "/problems/autocomplete.json", map[string]string{"text": text})
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.Tickets, nil
+}
+
+// GetTicketRelated gets the information related to a ticket, such as the number of incidents of a problem
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/tickets/#ticket-related-information
+func (z *Client) GetTicketRelated(ctx context.Context, ticketID int64) (TicketRelated, error) {
+	var result struct {
+		TicketRelated TicketRelated `json:"ticket_related"`
+	}
+
+	body, err := z.Get(ctx, fmt.Sprintf("/tickets/%d/related.json", ticketID))
+	if err != nil {
+		return TicketRelated{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return TicketRelated{}, err
+	}
+	return result.TicketRelated, nil
+}
+
+// LinkIncidents queues turning the tickets in incidentIDs into incidents of the problem, 100 tickets per job
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/tickets/#update-many-tickets
+func (z *Client) LinkIncidents(ctx context.Context, problemID int64, incidentIDs []int64) ([]BulkJob, error) {
+	if err := requireID("problemID", problemID); err != nil {
+		return nil, err
+	}
+	return z.UpdateManyTicketsWith(ctx, incidentIDs, NewTicketUpdate().SetType("incident").SetProblemID(problemID))
+}
+
+// SolveProblem solves the incidents of a problem which are not solved or closed yet with comment using bulk
+// updates, waits for the jobs with opts, then solves the problem with comment. The incidents are solved
+// first as Zendesk adds the comment of a solved problem to its unsolved incidents, which would otherwise
+// get it twice. The solved problem and the jobs are returned. If not all the incidents were solved, the
+// problem is left unsolved and the error is the one of the bulk update or of WaitForJobs, with the jobs
+// reporting the incidents which failed.
+func (z *Client) SolveProblem(
+	ctx context.Context, problemID int64, comment TicketComment, opts *JobWaitOptions,
+) (Ticket, []BulkJob, error) {
+	incidents, err := collect(z.IterateProblemIncidents(ctx, &ProblemIncidentIteratorOptions{ProblemID: problemID}))
+	if err != nil {
+		return Ticket{}, nil, err
+	}
+
+	var ids []int64
+	for _, incident := range incidents {
+		if incident.Status != "solved" && incident.Status != "closed" {
+			ids = append(ids, incident.ID)
+		}
+	}
+
+	var jobs []BulkJob
+	if len(ids) > 0 {
+		jobs, err = z.UpdateManyTicketsWith(ctx, ids, NewTicketUpdate().SetStatus("solved").AddComment(comment))
+		if err != nil {
+			return Ticket{}, jobs, err
+		}
+		if err := z.WaitForJobs(ctx, JobsOf(jobs), opts); err != nil {
+			return Ticket{}, jobs, err
+		}
+	}
+
+	problem, err := z.UpdateTicketWith(ctx, problemID, NewTicketUpdate().SetStatus("solved").AddComment(comment))
+	return problem, jobs, err
+}
@@ -0,0 +1,139 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/JacobPotter/go-zendesk/testhelper"
+)
+
+func TestListProblems(t *testing.T) {
+	mockAPI := testhelper.NewMockAPI(t, http.MethodGet, "problems.json")
+	c := NewTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	problems, err := c.ListProblems(ctx)
+	if err != nil {
+		t.Fatalf("Failed to list problems: %s", err)
+	}
+	if len(problems) != 2 || problems[0].Type != "problem" || !problems[0].HasIncidents {
+		t.Fatalf("Unexpected problems %v", problems)
+	}
+}
+
+func TestAutocompleteProblems(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if r.Method != http.MethodPost || r.URL.Path != "/problems/autocomplete.json" || string(body) != `{"text":"print"}` {
+			t.Errorf("Unexpected request %s %s %s", r.Method, r.URL.Path, body)
+		}
+		_, _ = w.Write(testhelper.ReadFixture(t, filepath.Join(http.MethodPost, "problems_autocomplete.json")))
+	}))
+	c := NewTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	problems, err := c.AutocompleteProblems(ctx, "print")
+	if err != nil {
+		t.Fatalf("Failed to autocomplete problems: %s", err)
+	}
+	if len(problems) != 1 || problems[0].ID != 33 {
+		t.Fatalf("Unexpected problems %v", problems)
+	}
+}
+
+func TestGetTicketRelated(t *testing.T) {
+	mockAPI := testhelper.NewMockAPI(t, http.MethodGet, "ticket_related.json")
+	c := NewTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	related, err := c.GetTicketRelated(ctx, 33)
+	if err != nil {
+		t.Fatalf("Failed to get ticket related: %s", err)
+	}
+	if related.Incidents != 3 || related.FromArchive {
+		t.Fatalf("Unexpected ticket related %v", related)
+	}
+}
+
+func TestLinkIncidents(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if r.URL.Query().Get("ids") != "35,36" || string(body) != `{"ticket":{"problem_id":33,"type":"incident"}}` {
+			t.Errorf("Unexpected update %s %s", r.URL.RawQuery, body)
+		}
+		_, _ = w.Write(testhelper.ReadFixture(t, filepath.Join(http.MethodPut, "update_many_tickets.json")))
+	}))
+	c := NewTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	jobs, err := c.LinkIncidents(ctx, 33, []int64{35, 36})
+	if err != nil {
+		t.Fatalf("Failed to link incidents: %s", err)
+	}
+	if len(jobs) != 1 || len(jobs[0].IDs) != 2 {
+		t.Fatalf("Unexpected jobs %v", jobs)
+	}
+
+	if _, err := c.LinkIncidents(ctx, 0, []int64{35}); err == nil {
+		t.Fatal("Expected an error without a problem id")
+	}
+}
+
+func TestSolveProblem(t *testing.T) {
+	var problemUpdate, incidentsUpdate string
+	var paths []string
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		paths = append(paths, r.URL.Path)
+		switch {
+		case r.Method == http.MethodPut && r.URL.Path == "/tickets/33.json":
+			problemUpdate = string(body)
+			_, _ = w.Write(testhelper.ReadFixture(t, filepath.Join(http.MethodPut, "ticket.json")))
+		case r.URL.Path == "/tickets/33/incidents.json":
+			if r.URL.Query().Has("active") {
+				t.Errorf("Unexpected active parameter %s", r.URL.RawQuery)
+			}
+			var data map[string]interface{}
+			_ = json.Unmarshal(testhelper.ReadFixture(t, filepath.Join(http.MethodGet, "problem_incidents.json")), &data)
+			// the last incident is already solved
+			tickets := data["tickets"].([]interface{})
+			tickets[2].(map[string]interface{})["status"] = "solved"
+			_ = json.NewEncoder(w).Encode(data)
+		case r.URL.Path == "/tickets/update_many.json":
+			if r.URL.Query().Get("ids") != "35,36" {
+				t.Errorf("Unexpected incidents %s", r.URL.RawQuery)
+			}
+			incidentsUpdate = string(body)
+			_, _ = w.Write(testhelper.ReadFixture(t, filepath.Join(http.MethodPut, "update_many_tickets.json")))
+		case r.URL.Path == "/job_statuses/show_many.json":
+			_, _ = w.Write([]byte(`{"job_statuses":[{"id":"8b726e606741012ffc2d782bcb7848fe","status":"completed"}]}`))
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	c := NewTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, jobs, err := c.SolveProblem(ctx, 33, NewPublicTicketComment("Fixed", 0), &JobWaitOptions{Interval: time.Millisecond})
+	if err != nil {
+		t.Fatalf("Failed to solve problem: %s", err)
+	}
+	if len(jobs) != 1 || jobs[0].Status != JobStatusCompleted {
+		t.Fatalf("Unexpected jobs %v", jobs)
+	}
+	// the problem is solved last, so that Zendesk does not add its comment to the incidents again
+	if paths[len(paths)-1] != "/tickets/33.json" {
+		t.Fatalf("Expected the problem to be solved after its incidents, got %v", paths)
+	}
+	for _, update := range []string{problemUpdate, incidentsUpdate} {
+		if !strings.Contains(update, `"status":"solved"`) || !strings.Contains(update, `"body":"Fixed"`) {
+			t.Fatalf("Unexpected update %s", update)
+		}
+	}
+}
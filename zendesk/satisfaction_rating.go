@@ -0,0 +1,102 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// SatisfactionRating is the CSAT rating of a ticket given by its requester
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/satisfaction_ratings/
+type SatisfactionRating struct {
+	ID          int64  `json:"id,omitempty"`
+	URL         string `json:"url,omitempty"`
+	AssigneeID  int64  `json:"assignee_id,omitempty"`
+	GroupID     int64  `json:"group_id,omitempty"`
+	RequesterID int64  `json:"requester_id,omitempty"`
+	TicketID    int64  `json:"ticket_id,omitempty"`
+
+	// Score can take "offered", "unoffered", "good" or "bad". Only "good" and "bad" can be created.
+	Score   string `json:"score"`
+	Comment string `json:"comment,omitempty"`
+
+	// Reason is the reason given for a bad rating, identified by ReasonCode or ReasonID
+	Reason     string `json:"reason,omitempty"`
+	ReasonCode int64  `json:"reason_code,omitempty"`
+	ReasonID   int64  `json:"reason_id,omitempty"`
+
+	CreatedAt *Timestamp `json:"created_at,omitempty"`
+	UpdatedAt *Timestamp `json:"updated_at,omitempty"`
+}
+
+// SatisfactionReason is a reason a requester can give for a bad satisfaction rating
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/satisfaction_reasons/
+type SatisfactionReason struct {
+	ID  int64  `json:"id,omitempty"`
+	URL string `json:"url,omitempty"`
+
+	// ReasonCode is the code of the reason in SatisfactionRating.ReasonCode
+	ReasonCode int64      `json:"reason_code,omitempty"`
+	Value      string     `json:"value"`
+	RawValue   string     `json:"raw_value,omitempty"`
+	Deleted    bool       `json:"deleted"`
+	CreatedAt  *Timestamp `json:"created_at,omitempty"`
+	UpdatedAt  *Timestamp `json:"updated_at,omitempty"`
+}
+
+// SatisfactionRatingAPI an interface containing the satisfaction rating methods which are not generated
+type SatisfactionRatingAPI interface {
+	CreateSatisfactionRating(ctx context.Context, ticketID int64, rating SatisfactionRating) (SatisfactionRating, error)
+	CreateSatisfactionRatingOnBehalfOf(
+		ctx context.Context, requesterEmail string, ticketID int64, rating SatisfactionRating,
+	) (SatisfactionRating, error)
+}
+
+// CreateSatisfactionRating rates a solved ticket. Only the requester of the ticket can rate it, so the
+// client must be authenticated as the requester; see CreateSatisfactionRatingOnBehalfOf otherwise.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/satisfaction_ratings/#create-a-satisfaction-rating
+func (z *Client) CreateSatisfactionRating(
+	ctx context.Context, ticketID int64, rating SatisfactionRating,
+) (SatisfactionRating, error) {
+	var data, result struct {
+		SatisfactionRating SatisfactionRating `json:"satisfaction_rating"`
+	}
+	data.SatisfactionRating = rating
+
+	body, err := z.Post(ctx, fmt.Sprintf("/tickets/%d/satisfaction_rating.json", ticketID), data)
+	if err != nil {
+		return SatisfactionRating{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return SatisfactionRating{}, err
+	}
+	return result.SatisfactionRating, nil
+}
+
+// CreateSatisfactionRatingOnBehalfOf rates a solved ticket on behalf of its requester, using the
+// X-On-Behalf-Of header. The client must be authenticated with an OAuth token having the impersonate scope.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/oauth/oauth_tokens/#scopes
+func (z *Client) CreateSatisfactionRatingOnBehalfOf(
+	ctx context.Context, requesterEmail string, ticketID int64, rating SatisfactionRating,
+) (SatisfactionRating, error) {
+	return z.onBehalfOf(requesterEmail).CreateSatisfactionRating(ctx, ticketID, rating)
+}
+
+// onBehalfOf returns a copy of the client sending its requests on behalf of the user with email
+func (z *Client) onBehalfOf(email string) *Client {
+	base := *z.BaseClient
+	base.Headers = make(map[string]string, len(z.Headers)+1)
+	for key, value := range z.Headers {
+		base.Headers[key] = value
+	}
+	base.Headers["X-On-Behalf-Of"] = email
+	c := *z
+	c.BaseClient = &base
+	return &c
+}
@@ -0,0 +1,100 @@
+package zendesk
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/JacobPotter/go-zendesk/testhelper"
+)
+
+func TestIterateSatisfactionRatings(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if query.Get("score") != "bad" || query.Get("start_time") != "1704067200" || query.Get("end_time") != "1706745600" {
+			t.Errorf("Unexpected query %s", r.URL.RawQuery)
+		}
+		_, _ = w.Write(testhelper.ReadFixture(t, filepath.Join(http.MethodGet, "satisfaction_ratings.json")))
+	}))
+	c := NewTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	it := c.IterateSatisfactionRatings(ctx, &SatisfactionRatingIteratorOptions{
+		Score: "bad", StartTime: 1704067200, EndTime: 1706745600,
+	})
+	ratings, err := collect(it)
+	if err != nil {
+		t.Fatalf("Failed to list satisfaction ratings: %s", err)
+	}
+	if len(ratings) != 2 || ratings[1].Score != "bad" || ratings[1].ReasonCode != 1002 || ratings[1].TicketID != 209 {
+		t.Fatalf("Unexpected satisfaction ratings %v", ratings)
+	}
+}
+
+func TestIterateSatisfactionRatingsRejectsInvalidScore(t *testing.T) {
+	mockAPI := testhelper.NewMockAPI(t, http.MethodGet, "satisfaction_ratings.json")
+	c := NewTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := c.IterateSatisfactionRatings(ctx, &SatisfactionRatingIteratorOptions{Score: "great"}).GetNext()
+	if err == nil {
+		t.Fatal("Expected an error for an invalid score")
+	}
+}
+
+func TestCreateSatisfactionRatingOnBehalfOf(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if r.Method != http.MethodPost || r.URL.Path != "/tickets/210/satisfaction_rating.json" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if string(body) != `{"satisfaction_rating":{"score":"good","comment":"Thanks"}}` {
+			t.Errorf("Unexpected body %s", body)
+		}
+		if r.Header.Get("X-On-Behalf-Of") != "requester@example.com" {
+			t.Errorf("Unexpected X-On-Behalf-Of %q", r.Header.Get("X-On-Behalf-Of"))
+		}
+		_, _ = w.Write(testhelper.ReadFixture(t, filepath.Join(http.MethodPost, "satisfaction_rating.json")))
+	}))
+	c := NewTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	rating, err := c.CreateSatisfactionRatingOnBehalfOf(ctx, "requester@example.com", 210,
+		SatisfactionRating{Score: "good", Comment: "Thanks"})
+	if err != nil {
+		t.Fatalf("Failed to create satisfaction rating: %s", err)
+	}
+	if rating.ID != 64 || rating.Score != "good" {
+		t.Fatalf("Unexpected satisfaction rating %v", rating)
+	}
+	if _, ok := c.Headers["X-On-Behalf-Of"]; ok {
+		t.Fatal("The header must not be set on the client")
+	}
+}
+
+func TestOnBehalfOfKeepsClientSettings(t *testing.T) {
+	mockAPI := testhelper.NewMockAPI(t, http.MethodGet, "satisfaction_reasons.json")
+	c := NewTestClient(mockAPI)
+	defer mockAPI.Close()
+	c.SetKeepExtraFields(true)
+
+	if !c.onBehalfOf("requester@example.com").keepExtraFields {
+		t.Fatal("Expected the copy to keep the extra fields like the client")
+	}
+}
+
+func TestGetSatisfactionReasons(t *testing.T) {
+	mockAPI := testhelper.NewMockAPI(t, http.MethodGet, "satisfaction_reasons.json")
+	c := NewTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	reasons, err := collect(c.IterateSatisfactionReasons(ctx, nil))
+	if err != nil {
+		t.Fatalf("Failed to list satisfaction reasons: %s", err)
+	}
+	if len(reasons) != 2 || reasons[1].ReasonCode != 1002 || reasons[1].Value != "Agent did not respond quickly" {
+		t.Fatalf("Unexpected satisfaction reasons %v", reasons)
+	}
+}
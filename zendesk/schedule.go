@@ -4,7 +4,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"time"
 )
 
 type ScheduleInterval struct {
@@ -17,8 +16,8 @@ type Schedule struct {
 	Intervals []ScheduleInterval `json:"intervals,omitempty"`
 	Name      string             `json:"name"`
 	TimeZone  string             `json:"time_zone"`
-	CreatedAt *time.Time         `json:"created_at,omitempty"`
-	UpdatedAt *time.Time         `json:"updated_at,omitempty"`
+	CreatedAt *Timestamp         `json:"created_at,omitempty"`
+	UpdatedAt *Timestamp         `json:"updated_at,omitempty"`
 }
 
 type ScheduleAPI interface {
@@ -3,6 +3,7 @@ package zendesk
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	client2 "github.com/JacobPotter/go-zendesk/client"
 )
@@ -24,12 +25,41 @@ type CountOptions struct {
 	Query string `url:"query"`
 }
 
+// SearchExportOptions are the options accepted by the SearchExport iterators.
+// The export API only supports cursor based pagination, so UseOBP is ignored.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/search/#export-search-results
+type SearchExportOptions struct {
+	IteratorOptions
+
+	// Query is required and takes the same syntax as SearchOptions.Query, without the type: keyword
+	Query string `url:"query"`
+}
+
+// searchExportListOptions adds the result type of a SearchExport iterator to SearchExportOptions
+type searchExportListOptions struct {
+	SearchExportOptions
+	FilterType string `url:"filter[type]"`
+}
+
+// Validate checks that a query is set and that the result type is supported by the export API
+func (o searchExportListOptions) Validate() error {
+	if o.Query == "" {
+		return errors.New("query is required")
+	}
+	return validateOption("filter[type]", o.FilterType, "ticket", "user", "organization", "group")
+}
+
 type SearchAPI interface {
 	Search(ctx context.Context, opts *SearchOptions) (SearchResults, Page, error)
 	SearchCount(ctx context.Context, opts *CountOptions) (int, error)
 	GetSearchIterator(ctx context.Context, opts *PaginationOptions) *Iterator[SearchResults]
 	GetSearchOBP(ctx context.Context, opts *OBPOptions) ([]SearchResults, Page, error)
 	GetSearchCBP(ctx context.Context, opts *CBPOptions) ([]SearchResults, client2.CursorPaginationMeta, error)
+	SearchExportTickets(ctx context.Context, opts *SearchExportOptions) *Iterator[Ticket]
+	SearchExportUsers(ctx context.Context, opts *SearchExportOptions) *Iterator[User]
+	SearchExportOrganizations(ctx context.Context, opts *SearchExportOptions) *Iterator[Organization]
+	SearchExportGroups(ctx context.Context, opts *SearchExportOptions) *Iterator[Group]
 }
 
 type SearchResults struct {
@@ -182,3 +212,71 @@ func (z *Client) SearchCount(ctx context.Context, opts *CountOptions) (int, erro
 
 	return data.Count, nil
 }
+
+// SearchExportTickets returns an Iterator over the tickets matching opts.Query. Unlike Search, the
+// export API is cursor based and is not capped at 1000 results.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/search/#export-search-results
+func (z *Client) SearchExportTickets(ctx context.Context, opts *SearchExportOptions) *Iterator[Ticket] {
+	return newSearchExportIterator[Ticket](z, ctx, opts, "ticket")
+}
+
+// SearchExportUsers returns an Iterator over the users matching opts.Query
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/search/#export-search-results
+func (z *Client) SearchExportUsers(ctx context.Context, opts *SearchExportOptions) *Iterator[User] {
+	return newSearchExportIterator[User](z, ctx, opts, "user")
+}
+
+// SearchExportOrganizations returns an Iterator over the organizations matching opts.Query
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/search/#export-search-results
+func (z *Client) SearchExportOrganizations(
+	ctx context.Context, opts *SearchExportOptions,
+) *Iterator[Organization] {
+	return newSearchExportIterator[Organization](z, ctx, opts, "organization")
+}
+
+// SearchExportGroups returns an Iterator over the groups matching opts.Query
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/ticket-management/search/#export-search-results
+func (z *Client) SearchExportGroups(ctx context.Context, opts *SearchExportOptions) *Iterator[Group] {
+	return newSearchExportIterator[Group](z, ctx, opts, "group")
+}
+
+// newSearchExportIterator returns a cursor based Iterator over the export results of filterType
+func newSearchExportIterator[T any](
+	z *Client, ctx context.Context, opts *SearchExportOptions, filterType string,
+) *Iterator[T] {
+	if opts == nil {
+		opts = &SearchExportOptions{}
+	}
+	listOpts := searchExportListOptions{SearchExportOptions: *opts, FilterType: filterType}
+	pagination := IteratorOptions{PageSize: opts.PageSize}
+
+	return newListIterator[T](ctx, pagination, listOpts, 0, nil,
+		func(ctx context.Context, opts *CBPOptions) ([]T, client2.CursorPaginationMeta, error) {
+			return getSearchExportCBP[T](z, ctx, opts)
+		})
+}
+
+// getSearchExportCBP fetches a page of /search/export.json
+func getSearchExportCBP[T any](
+	z *Client, ctx context.Context, opts *CBPOptions,
+) ([]T, client2.CursorPaginationMeta, error) {
+	var data struct {
+		Results []T                          `json:"results"`
+		Meta    client2.CursorPaginationMeta `json:"meta"`
+	}
+
+	u, err := opts.addOptions("/search/export.json")
+	if err != nil {
+		return nil, data.Meta, err
+	}
+
+	err = client2.GetData(z, ctx, u, &data)
+	if err != nil {
+		return nil, data.Meta, err
+	}
+	return data.Results, data.Meta, nil
+}
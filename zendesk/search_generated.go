@@ -1,9 +1,9 @@
 // Code generated by Script. DO NOT EDIT.
-// Source: script/codegen/main.go
+// Source: script/codegen/resources.yaml
 //
 // Generated by this command:
 //
-//	go run script/codegen/main.go
+//	go run ./script/codegen
 
 package zendesk
 
@@ -12,6 +12,7 @@ import (
 	"github.com/JacobPotter/go-zendesk/client"
 )
 
+// GetSearchIterator returns an Iterator over /search.json
 func (z *Client) GetSearchIterator(ctx context.Context, opts *PaginationOptions) *Iterator[SearchResults] {
 	return &Iterator[SearchResults]{
 		CommonOptions: opts.CommonOptions,
@@ -26,9 +27,10 @@ func (z *Client) GetSearchIterator(ctx context.Context, opts *PaginationOptions)
 	}
 }
 
+// GetSearchOBP fetches a page of /search.json with offset based pagination
 func (z *Client) GetSearchOBP(ctx context.Context, opts *OBPOptions) ([]SearchResults, Page, error) {
 	var data struct {
-		SearchResultss []SearchResults `json:"results"`
+		Search []SearchResults `json:"results"`
 		Page
 	}
 
@@ -37,39 +39,19 @@ func (z *Client) GetSearchOBP(ctx context.Context, opts *OBPOptions) ([]SearchRe
 		tmp = &OBPOptions{}
 	}
 
-	u, err := client.AddOptions("/search.json", tmp)
-
+	u, err := tmp.addOptions("/search.json")
 	if err != nil {
 		return nil, Page{}, err
 	}
 
-	err = client.GetData(z, ctx, u, &data)
+	err = z.getData(ctx, u, &data)
 	if err != nil {
 		return nil, Page{}, err
 	}
-	return data.SearchResultss, data.Page, nil
+	return data.Search, data.Page, nil
 }
 
+// GetSearchCBP always returns an *InvalidOptionError: /search.json does not support cursor based pagination
 func (z *Client) GetSearchCBP(ctx context.Context, opts *CBPOptions) ([]SearchResults, client.CursorPaginationMeta, error) {
-	var data struct {
-		SearchResultss []SearchResults             `json:"results"`
-		Meta           client.CursorPaginationMeta `json:"meta"`
-	}
-
-	tmp := opts
-	if tmp == nil {
-		tmp = &CBPOptions{}
-	}
-
-	u, err := client.AddOptions("/search.json", tmp)
-
-	if err != nil {
-		return nil, data.Meta, err
-	}
-
-	err = client.GetData(z, ctx, u, &data)
-	if err != nil {
-		return nil, data.Meta, err
-	}
-	return data.SearchResultss, data.Meta, nil
+	return nil, client.CursorPaginationMeta{}, cbpUnsupported()
 }
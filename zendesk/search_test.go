@@ -1,9 +1,11 @@
 package zendesk
 
 import (
+	"errors"
 	"github.com/JacobPotter/go-zendesk/testhelper"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"path/filepath"
 	"testing"
 )
@@ -128,3 +130,84 @@ func TestSearchQueryParam(t *testing.T) {
 		t.Fatalf("Received error from search api")
 	}
 }
+
+func TestSearchExportTickets(t *testing.T) {
+	var query url.Values
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/search/export.json" {
+			t.Fatalf("Unexpected path %s", r.URL.Path)
+		}
+		query = r.URL.Query()
+		_, err := w.Write(testhelper.ReadFixture(t, filepath.Join(http.MethodGet, "search_export_tickets.json")))
+		if err != nil {
+			t.Fatalf("Failed to write fixture: %s", err)
+		}
+	}))
+	c := NewTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	it := c.SearchExportTickets(ctx, &SearchExportOptions{
+		IteratorOptions: IteratorOptions{PageSize: 500},
+		Query:           "status:open printer",
+	})
+
+	var tickets []Ticket
+	for it.HasMore() {
+		page, err := it.GetNext()
+		if err != nil {
+			t.Fatalf("Failed to export tickets: %s", err)
+		}
+		tickets = append(tickets, page...)
+	}
+
+	if len(tickets) != 2 || tickets[0].ID != 35436 {
+		t.Fatalf("Unexpected tickets %v", tickets)
+	}
+	if query.Get("filter[type]") != "ticket" || query.Get("query") != "status:open printer" ||
+		query.Get("page[size]") != "500" {
+		t.Fatalf("Unexpected query %s", query.Encode())
+	}
+}
+
+func TestSearchExportUsers(t *testing.T) {
+	mockAPI := testhelper.NewMockAPI(t, http.MethodGet, "search_export_users.json")
+	c := NewTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	users, err := c.SearchExportUsers(ctx, &SearchExportOptions{Query: "jane"}).GetNext()
+	if err != nil {
+		t.Fatalf("Failed to export users: %s", err)
+	}
+	if len(users) != 1 || users[0].Email != "jane@example.com" {
+		t.Fatalf("Unexpected users %v", users)
+	}
+}
+
+func TestSearchExportRequiresQuery(t *testing.T) {
+	mockAPI := testhelper.NewMockAPI(t, http.MethodGet, "search_export_users.json")
+	c := NewTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	it := c.SearchExportGroups(ctx, nil)
+	if _, err := it.GetNext(); err == nil {
+		t.Fatal("Expected an error for a missing query")
+	}
+	if it.HasMore() {
+		t.Fatal("Expected iterator to stop after a validation error")
+	}
+}
+
+func TestSearchIteratorRejectsCBP(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatalf("Unexpected request to %s", r.URL.Path)
+	}))
+	c := NewTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	it := c.GetSearchIterator(ctx, NewPaginationOptions())
+	_, err := it.GetNext()
+	var optErr *InvalidOptionError
+	if !errors.As(err, &optErr) || optErr.Option != "pagination" {
+		t.Fatalf("Expected cursor pagination to be rejected, got %v", err)
+	}
+}
@@ -0,0 +1,225 @@
+package zendesk
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/JacobPotter/go-zendesk/client"
+)
+
+// ticketSideloads are the include values accepted by the ticket endpoints
+//
+// ref: https://developer.zendesk.com/documentation/ticketing/using-the-zendesk-api/side_loading/
+var ticketSideloads = []string{
+	"users", "groups", "organizations", "brands", "metric_sets", "comment_count", "dates",
+}
+
+// userSideloads are the include values accepted by the user endpoints
+var userSideloads = []string{"organizations", "groups"}
+
+// organizationSideloads are the include values accepted by the organization endpoints
+var organizationSideloads = []string{"users", "groups"}
+
+// Sideloads holds the collections side-loaded with include=.
+// CommentCounts and Dates are returned inside each ticket and are keyed by ticket id.
+type Sideloads struct {
+	Users         []User         `json:"users,omitempty"`
+	Groups        []Group        `json:"groups,omitempty"`
+	Organizations []Organization `json:"organizations,omitempty"`
+	Brands        []Brand        `json:"brands,omitempty"`
+	MetricSets    []TicketMetric `json:"metric_sets,omitempty"`
+
+	CommentCounts map[int64]int         `json:"-"`
+	Dates         map[int64]TicketDates `json:"-"`
+}
+
+// TicketDates is the dates side-load of a ticket
+type TicketDates struct {
+	AssigneeUpdatedAt    *Timestamp `json:"assignee_updated_at,omitempty"`
+	RequesterUpdatedAt   *Timestamp `json:"requester_updated_at,omitempty"`
+	StatusUpdatedAt      *Timestamp `json:"status_updated_at,omitempty"`
+	InitiallyAssignedAt  *Timestamp `json:"initially_assigned_at,omitempty"`
+	AssignedAt           *Timestamp `json:"assigned_at,omitempty"`
+	SolvedAt             *Timestamp `json:"solved_at,omitempty"`
+	LatestCommentAddedAt *Timestamp `json:"latest_comment_added_at,omitempty"`
+}
+
+// User returns the side-loaded user with the given id
+func (s Sideloads) User(id int64) (User, bool) {
+	for _, u := range s.Users {
+		if u.ID == id {
+			return u, true
+		}
+	}
+	return User{}, false
+}
+
+// Group returns the side-loaded group with the given id
+func (s Sideloads) Group(id int64) (Group, bool) {
+	for _, g := range s.Groups {
+		if g.ID == id {
+			return g, true
+		}
+	}
+	return Group{}, false
+}
+
+// Organization returns the side-loaded organization with the given id
+func (s Sideloads) Organization(id int64) (Organization, bool) {
+	for _, o := range s.Organizations {
+		if o.ID == id {
+			return o, true
+		}
+	}
+	return Organization{}, false
+}
+
+// decodeSideloads decodes the side-loaded collections of a response and the comment_count and dates
+// side-loads of the items under envelope, which holds either a list or a single item.
+// The envelope itself is skipped so that e.g. the users of a user listing are not mistaken for side-loads.
+func decodeSideloads(raw map[string]json.RawMessage, envelope string) (Sideloads, error) {
+	var sideloads Sideloads
+
+	rest := make(map[string]json.RawMessage, len(raw))
+	for key, value := range raw {
+		if key != envelope {
+			rest[key] = value
+		}
+	}
+	b, err := json.Marshal(rest)
+	if err != nil {
+		return sideloads, err
+	}
+	if err := json.Unmarshal(b, &sideloads); err != nil {
+		return sideloads, err
+	}
+
+	items, ok := raw[envelope]
+	if !ok {
+		return sideloads, nil
+	}
+
+	var perItem []struct {
+		ID           int64        `json:"id"`
+		CommentCount *int         `json:"comment_count"`
+		Dates        *TicketDates `json:"dates"`
+	}
+	items = bytes.TrimSpace(items)
+	if bytes.HasPrefix(items, []byte("{")) {
+		items = append(append([]byte("["), items...), ']')
+	}
+	if err := json.Unmarshal(items, &perItem); err != nil {
+		return sideloads, fmt.Errorf("%s: %w", envelope, err)
+	}
+
+	for _, item := range perItem {
+		if item.CommentCount != nil {
+			if sideloads.CommentCounts == nil {
+				sideloads.CommentCounts = map[int64]int{}
+			}
+			sideloads.CommentCounts[item.ID] = *item.CommentCount
+		}
+		if item.Dates != nil {
+			if sideloads.Dates == nil {
+				sideloads.Dates = map[int64]TicketDates{}
+			}
+			sideloads.Dates[item.ID] = *item.Dates
+		}
+	}
+	return sideloads, nil
+}
+
+// sideloadPage is a page of a listing requested with include=
+type sideloadPage struct {
+	Page
+	Meta      client.CursorPaginationMeta `json:"meta"`
+	Sideloads Sideloads                   `json:"-"`
+}
+
+// getSideloads fetches u and decodes the value under envelope into result, together with the side-loads
+func getSideloads(z *Client, ctx context.Context, u, envelope string, result interface{}) (sideloadPage, error) {
+	var page sideloadPage
+
+	body, err := z.Get(ctx, u)
+	if err != nil {
+		return page, err
+	}
+
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return page, err
+	}
+	if err := json.Unmarshal(body, &page); err != nil {
+		return page, err
+	}
+	if data, ok := raw[envelope]; ok {
+		if err := json.Unmarshal(data, result); err != nil {
+			return page, fmt.Errorf("%s: %w", envelope, err)
+		}
+	}
+
+	page.Sideloads, err = decodeSideloads(raw, envelope)
+	return page, err
+}
+
+// getWithSideloads fetches a single item and its side-loads
+func getWithSideloads[T any](
+	z *Client, ctx context.Context, path, envelope, include string, allowed ...string,
+) (T, Sideloads, error) {
+	var item T
+
+	if err := validateInclude(include, allowed...); err != nil {
+		return item, Sideloads{}, err
+	}
+	u, err := client.AddOptions(path, struct {
+		Include string `url:"include,omitempty"`
+	}{include})
+	if err != nil {
+		return item, Sideloads{}, err
+	}
+
+	page, err := getSideloads(z, ctx, u, envelope, &item)
+	if err != nil {
+		return item, Sideloads{}, err
+	}
+	return item, page.Sideloads, nil
+}
+
+// newSideloadIterator returns an Iterator over path whose Sideloads are updated with every page
+func newSideloadIterator[T any](
+	ctx context.Context, z *Client, pagination IteratorOptions, opts ListOptions, path, envelope string,
+) *Iterator[T] {
+	it := newListIterator[T](ctx, pagination, opts, 0, nil, nil)
+
+	it.obpFunc = func(ctx context.Context, opts *OBPOptions) ([]T, Page, error) {
+		u, err := opts.addOptions(path)
+		if err != nil {
+			return nil, Page{}, err
+		}
+		var items []T
+		page, err := getSideloads(z, ctx, u, envelope, &items)
+		if err != nil {
+			return nil, Page{}, err
+		}
+		it.sideloads = page.Sideloads
+		return items, page.Page, nil
+	}
+
+	it.cbpFunc = func(ctx context.Context, opts *CBPOptions) ([]T, client.CursorPaginationMeta, error) {
+		u, err := opts.addOptions(path)
+		if err != nil {
+			return nil, client.CursorPaginationMeta{}, err
+		}
+		var items []T
+		page, err := getSideloads(z, ctx, u, envelope, &items)
+		if err != nil {
+			return nil, page.Meta, err
+		}
+		it.sideloads = page.Sideloads
+		return items, page.Meta, nil
+	}
+
+	return it
+}
@@ -2,10 +2,8 @@ package zendesk
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	client2 "github.com/JacobPotter/go-zendesk/client"
-	"time"
 )
 
 // SLA Policy metric values
@@ -64,8 +62,11 @@ type SLAPolicy struct {
 	Filter         Conditions        `json:"filter"`
 	PolicyMetrics  []SLAPolicyMetric `json:"policy_metrics,omitempty"`
 	MetricSettings MetricSettings    `json:"metric_settings,omitempty"`
-	CreatedAt      *time.Time        `json:"created_at,omitempty"`
-	UpdatedAt      *time.Time        `json:"updated_at,omitempty"`
+	CreatedAt      *Timestamp        `json:"created_at,omitempty"`
+	UpdatedAt      *Timestamp        `json:"updated_at,omitempty"`
+
+	// Extra keeps the policy fields this struct lacks, see SetKeepExtraFields
+	Extra ExtraFields `json:"-"`
 }
 
 // SLAPolicyListOptions is options for GetSLAPolicies
@@ -113,7 +114,7 @@ func (z *Client) GetSLAPolicies(ctx context.Context, opts *SLAPolicyListOptions)
 		return []SLAPolicy{}, Page{}, err
 	}
 
-	err = json.Unmarshal(body, &data)
+	err = z.unmarshal(body, &data)
 	if err != nil {
 		return []SLAPolicy{}, Page{}, err
 	}
@@ -131,12 +132,12 @@ func (z *Client) CreateSLAPolicy(ctx context.Context, slaPolicy SLAPolicy) (SLAP
 
 	data.SLAPolicy = slaPolicy
 
-	body, err := z.Post(ctx, "/slas/policies.json", data)
+	body, err := z.Post(ctx, "/slas/policies.json", withExtraFields(data))
 	if err != nil {
 		return SLAPolicy{}, err
 	}
 
-	err = json.Unmarshal(body, &result)
+	err = z.unmarshal(body, &result)
 	if err != nil {
 		return SLAPolicy{}, err
 	}
@@ -157,7 +158,7 @@ func (z *Client) GetSLAPolicy(ctx context.Context, id int64) (SLAPolicy, error)
 		return SLAPolicy{}, err
 	}
 
-	err = json.Unmarshal(body, &result)
+	err = z.unmarshal(body, &result)
 	if err != nil {
 		return SLAPolicy{}, err
 	}
@@ -175,12 +176,12 @@ func (z *Client) UpdateSLAPolicy(ctx context.Context, id int64, slaPolicy SLAPol
 
 	data.SLAPolicy = slaPolicy
 
-	body, err := z.Put(ctx, fmt.Sprintf("/slas/policies/%d.json", id), data)
+	body, err := z.Put(ctx, fmt.Sprintf("/slas/policies/%d.json", id), withExtraFields(data))
 	if err != nil {
 		return SLAPolicy{}, err
 	}
 
-	err = json.Unmarshal(body, &result)
+	err = z.unmarshal(body, &result)
 	if err != nil {
 		return SLAPolicy{}, err
 	}
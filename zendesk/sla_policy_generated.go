@@ -1,9 +1,9 @@
 // Code generated by Script. DO NOT EDIT.
-// Source: script/codegen/main.go
+// Source: script/codegen/resources.yaml
 //
 // Generated by this command:
 //
-//	go run script/codegen/main.go
+//	go run ./script/codegen
 
 package zendesk
 
@@ -12,6 +12,7 @@ import (
 	"github.com/JacobPotter/go-zendesk/client"
 )
 
+// GetSLAPoliciesIterator returns an Iterator over /slas/policies.json
 func (z *Client) GetSLAPoliciesIterator(ctx context.Context, opts *PaginationOptions) *Iterator[SLAPolicy] {
 	return &Iterator[SLAPolicy]{
 		CommonOptions: opts.CommonOptions,
@@ -26,9 +27,10 @@ func (z *Client) GetSLAPoliciesIterator(ctx context.Context, opts *PaginationOpt
 	}
 }
 
+// GetSLAPoliciesOBP fetches a page of /slas/policies.json with offset based pagination
 func (z *Client) GetSLAPoliciesOBP(ctx context.Context, opts *OBPOptions) ([]SLAPolicy, Page, error) {
 	var data struct {
-		SLAPolicys []SLAPolicy `json:"sla_policies"`
+		SLAPolicies []SLAPolicy `json:"sla_policies"`
 		Page
 	}
 
@@ -37,23 +39,23 @@ func (z *Client) GetSLAPoliciesOBP(ctx context.Context, opts *OBPOptions) ([]SLA
 		tmp = &OBPOptions{}
 	}
 
-	u, err := client.AddOptions("/slas/policies.json", tmp)
-
+	u, err := tmp.addOptions("/slas/policies.json")
 	if err != nil {
 		return nil, Page{}, err
 	}
 
-	err = client.GetData(z, ctx, u, &data)
+	err = z.getData(ctx, u, &data)
 	if err != nil {
 		return nil, Page{}, err
 	}
-	return data.SLAPolicys, data.Page, nil
+	return data.SLAPolicies, data.Page, nil
 }
 
+// GetSLAPoliciesCBP fetches a page of /slas/policies.json with cursor based pagination
 func (z *Client) GetSLAPoliciesCBP(ctx context.Context, opts *CBPOptions) ([]SLAPolicy, client.CursorPaginationMeta, error) {
 	var data struct {
-		SLAPolicys []SLAPolicy                 `json:"sla_policies"`
-		Meta       client.CursorPaginationMeta `json:"meta"`
+		SLAPolicies []SLAPolicy                 `json:"sla_policies"`
+		Meta        client.CursorPaginationMeta `json:"meta"`
 	}
 
 	tmp := opts
@@ -61,15 +63,14 @@ func (z *Client) GetSLAPoliciesCBP(ctx context.Context, opts *CBPOptions) ([]SLA
 		tmp = &CBPOptions{}
 	}
 
-	u, err := client.AddOptions("/slas/policies.json", tmp)
-
+	u, err := tmp.addOptions("/slas/policies.json")
 	if err != nil {
 		return nil, data.Meta, err
 	}
 
-	err = client.GetData(z, ctx, u, &data)
+	err = z.getData(ctx, u, &data)
 	if err != nil {
 		return nil, data.Meta, err
 	}
-	return data.SLAPolicys, data.Meta, nil
+	return data.SLAPolicies, data.Meta, nil
 }
@@ -0,0 +1,118 @@
+package zendesk
+
+import (
+	"sort"
+	"time"
+)
+
+// SLAStatus is the state of a metric of a ticket, folded from its metric events by TicketSLAStatus
+type SLAStatus struct {
+	TicketID int64
+	Metric   string
+
+	// InstanceID is the instance of the metric the status is for, e.g. the second reply for reply_time
+	InstanceID int64
+
+	// SLA is the SLA target of the metric, or nil when no SLA applies to it
+	SLA *TicketMetricEventSLA
+
+	// Active is true while the metric is being measured, Paused while its measure is suspended, such as
+	// a pausable update time while the ticket is pending
+	Active bool
+	Paused bool
+
+	Fulfilled   bool
+	ActivatedAt time.Time
+	FulfilledAt time.Time
+
+	// BreachAt is when the SLA target is, or was, missed. It is zero when no breach is scheduled.
+	BreachAt time.Time
+	Breached bool
+}
+
+// TicketSLAStatus folds the metric events of a ticket into the status of each of its metrics at the
+// given time, by metric. Only the latest instance of each metric is reported. events can be in any
+// order and may include the events of other tickets, e.g. a page of IncrementalTicketMetricEvents.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/ticket_metric_events/
+func TicketSLAStatus(ticketID int64, events []TicketMetricEvent, at time.Time) map[string]SLAStatus {
+	// breach events are recorded ahead of their time, when the breach is scheduled. A future breach is only
+	// kept if its instance was started by then: an SLA applied after at must not show up before it.
+	type instance struct {
+		metric string
+		id     int64
+	}
+	started := map[instance]bool{}
+	for _, event := range events {
+		if event.TicketID != ticketID || event.Time.AsTime().After(at) {
+			continue
+		}
+		switch event.Type {
+		case TicketMetricEventApplySLA, TicketMetricEventApplyGroupSLA, TicketMetricEventActivate:
+			started[instance{event.Metric, event.InstanceID}] = true
+		}
+	}
+
+	var sorted []TicketMetricEvent
+	for _, event := range events {
+		if event.TicketID != ticketID {
+			continue
+		}
+		if !event.Time.AsTime().After(at) ||
+			event.Type == TicketMetricEventBreach && started[instance{event.Metric, event.InstanceID}] {
+			sorted = append(sorted, event)
+		}
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if !sorted[i].Time.AsTime().Equal(sorted[j].Time.AsTime()) {
+			return sorted[i].Time.AsTime().Before(sorted[j].Time.AsTime())
+		}
+		return sorted[i].ID < sorted[j].ID
+	})
+
+	statuses := map[string]SLAStatus{}
+	for _, event := range sorted {
+		status, ok := statuses[event.Metric]
+		if !ok {
+			status = SLAStatus{TicketID: ticketID, Metric: event.Metric, InstanceID: event.InstanceID}
+		}
+		if event.InstanceID < status.InstanceID || event.InstanceID > status.InstanceID && event.Time.AsTime().After(at) {
+			continue
+		}
+		if event.InstanceID > status.InstanceID {
+			// a new instance starts from scratch, under the SLA of the previous one until one is applied
+			status = SLAStatus{TicketID: ticketID, Metric: event.Metric, InstanceID: event.InstanceID, SLA: status.SLA}
+		}
+
+		switch event.Type {
+		case TicketMetricEventApplySLA:
+			status.SLA = event.SLA
+		case TicketMetricEventApplyGroupSLA:
+			status.SLA = event.GroupSLA
+		case TicketMetricEventActivate:
+			status.Active, status.Paused = true, false
+			if status.ActivatedAt.IsZero() {
+				status.ActivatedAt = event.Time.AsTime()
+			}
+		case TicketMetricEventPause:
+			status.Active, status.Paused = false, true
+		case TicketMetricEventFulfill:
+			status.Active, status.Paused = false, false
+			status.Fulfilled, status.FulfilledAt = true, event.Time.AsTime()
+		case TicketMetricEventBreach:
+			if !event.Deleted {
+				status.BreachAt = event.Time.AsTime()
+			} else if status.BreachAt.Equal(event.Time.AsTime()) {
+				status.BreachAt = time.Time{}
+			}
+		}
+		statuses[event.Metric] = status
+	}
+
+	for metric, status := range statuses {
+		status.Breached = !status.BreachAt.IsZero() && !status.BreachAt.After(at) &&
+			(!status.Fulfilled || status.FulfilledAt.After(status.BreachAt))
+		statuses[metric] = status
+	}
+	return statuses
+}
@@ -0,0 +1,109 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestTicketSLAStatus(t *testing.T) {
+	data := `[
+	{"id":1,"ticket_id":1,"metric":"reply_time","instance_id":1,"type":"apply_sla","time":"2024-03-05T10:00:00Z",
+		"sla":{"target":60,"business_hours":false,"policy":{"id":9,"title":"Urgent"}}},
+	{"id":2,"ticket_id":1,"metric":"reply_time","instance_id":1,"type":"activate","time":"2024-03-05T10:00:00Z"},
+	{"id":3,"ticket_id":1,"metric":"reply_time","instance_id":1,"type":"breach","time":"2024-03-05T11:00:00Z"},
+	{"id":4,"ticket_id":1,"metric":"resolution_time","instance_id":1,"type":"apply_sla","time":"2024-03-05T10:00:00Z",
+		"sla":{"target":240,"business_hours":true,"policy":{"id":9,"title":"Urgent"}}},
+	{"id":5,"ticket_id":1,"metric":"resolution_time","instance_id":1,"type":"activate","time":"2024-03-05T10:00:00Z"},
+	{"id":6,"ticket_id":1,"metric":"resolution_time","instance_id":1,"type":"breach","time":"2024-03-05T14:00:00Z"},
+	{"id":7,"ticket_id":1,"metric":"pausable_update_time","instance_id":1,"type":"activate","time":"2024-03-05T10:00:00Z"},
+	{"id":8,"ticket_id":1,"metric":"pausable_update_time","instance_id":1,"type":"breach","time":"2024-03-05T12:00:00Z"},
+	{"id":9,"ticket_id":1,"metric":"pausable_update_time","instance_id":1,"type":"pause","time":"2024-03-05T10:20:00Z"},
+	{"id":10,"ticket_id":1,"metric":"pausable_update_time","instance_id":1,"type":"breach","time":"2024-03-05T12:00:00Z",
+		"deleted":true},
+	{"id":11,"ticket_id":1,"metric":"resolution_time","instance_id":1,"type":"fulfill","time":"2024-03-05T10:40:00Z"},
+	{"id":12,"ticket_id":2,"metric":"reply_time","instance_id":1,"type":"fulfill","time":"2024-03-05T10:10:00Z"}
+	]`
+
+	var events []TicketMetricEvent
+	if err := json.Unmarshal([]byte(data), &events); err != nil {
+		t.Fatalf("Failed to unmarshal events: %s", err)
+	}
+	at := func(hour, minute int) time.Time {
+		return time.Date(2024, time.March, 5, hour, minute, 0, 0, time.UTC)
+	}
+
+	statuses := TicketSLAStatus(1, events, at(10, 30))
+	if len(statuses) != 3 {
+		t.Fatalf("Expected 3 metrics, got %v", statuses)
+	}
+
+	reply := statuses["reply_time"]
+	if reply.SLA == nil || reply.SLA.Target != 60 || reply.SLA.Policy.Title != "Urgent" {
+		t.Fatalf("Unexpected SLA of reply_time: %v", reply.SLA)
+	}
+	if !reply.Active || reply.Breached || !reply.BreachAt.Equal(at(11, 0)) || !reply.ActivatedAt.Equal(at(10, 0)) {
+		t.Fatalf("Unexpected reply_time status at 10:30: %+v", reply)
+	}
+
+	update := statuses["pausable_update_time"]
+	if update.Active || !update.Paused || !update.BreachAt.IsZero() || update.SLA != nil {
+		t.Fatalf("Unexpected pausable_update_time status: %+v", update)
+	}
+
+	statuses = TicketSLAStatus(1, events, at(15, 0))
+	if reply := statuses["reply_time"]; !reply.Breached || reply.Fulfilled {
+		t.Fatalf("Expected reply_time to be breached at 15:00: %+v", reply)
+	}
+	resolution := statuses["resolution_time"]
+	if !resolution.Fulfilled || resolution.Active || resolution.Breached || !resolution.FulfilledAt.Equal(at(10, 40)) {
+		t.Fatalf("Unexpected resolution_time status at 15:00: %+v", resolution)
+	}
+}
+
+func TestTicketSLAStatusNewInstance(t *testing.T) {
+	events := []TicketMetricEvent{
+		{ID: 1, TicketID: 1, Metric: "reply_time", InstanceID: 1, Type: TicketMetricEventApplySLA,
+			Time: NewTimestamp(time.Unix(100, 0)), SLA: &TicketMetricEventSLA{Target: 10}},
+		{ID: 2, TicketID: 1, Metric: "reply_time", InstanceID: 1, Type: TicketMetricEventActivate,
+			Time: NewTimestamp(time.Unix(100, 0))},
+		{ID: 3, TicketID: 1, Metric: "reply_time", InstanceID: 1, Type: TicketMetricEventFulfill,
+			Time: NewTimestamp(time.Unix(200, 0))},
+		{ID: 4, TicketID: 1, Metric: "reply_time", InstanceID: 2, Type: TicketMetricEventActivate,
+			Time: NewTimestamp(time.Unix(300, 0))},
+	}
+
+	status := TicketSLAStatus(1, events, time.Unix(400, 0))["reply_time"]
+	if status.InstanceID != 2 || !status.Active || status.Fulfilled || status.SLA == nil || status.SLA.Target != 10 {
+		t.Fatalf("Unexpected status of the second reply: %+v", status)
+	}
+
+	status = TicketSLAStatus(1, events, time.Unix(250, 0))["reply_time"]
+	if status.InstanceID != 1 || !status.Fulfilled {
+		t.Fatalf("Unexpected status of the first reply: %+v", status)
+	}
+}
+
+func TestTicketSLAStatusFutureInstance(t *testing.T) {
+	at := func(hour, minute int) time.Time {
+		return time.Date(2024, time.March, 5, hour, minute, 0, 0, time.UTC)
+	}
+	events := []TicketMetricEvent{
+		{ID: 1, TicketID: 1, Metric: "reply_time", InstanceID: 1, Type: TicketMetricEventApplySLA,
+			Time: NewTimestamp(at(11, 0)), SLA: &TicketMetricEventSLA{Target: 60}},
+		{ID: 2, TicketID: 1, Metric: "reply_time", InstanceID: 1, Type: TicketMetricEventActivate,
+			Time: NewTimestamp(at(11, 0))},
+		{ID: 3, TicketID: 1, Metric: "reply_time", InstanceID: 1, Type: TicketMetricEventBreach,
+			Time: NewTimestamp(at(12, 0))},
+	}
+
+	// the SLA is applied after 10:30, so its scheduled breach must not show up yet
+	if status, ok := TicketSLAStatus(1, events, at(10, 30))["reply_time"]; ok {
+		t.Fatalf("Unexpected reply_time status at 10:30: %+v", status)
+	}
+
+	status := TicketSLAStatus(1, events, at(11, 30))["reply_time"]
+	if !status.Active || status.Breached || !status.BreachAt.Equal(at(12, 0)) || status.SLA == nil {
+		t.Fatalf("Unexpected reply_time status at 11:30: %+v", status)
+	}
+}
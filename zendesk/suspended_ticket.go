@@ -0,0 +1,180 @@
+package zendesk
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/JacobPotter/go-zendesk/client"
+)
+
+// SuspendedTicket is an incoming message which was suspended instead of creating or updating a ticket
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/suspended_tickets/
+type SuspendedTicket struct {
+	ID     int64  `json:"id,omitempty"`
+	URL    string `json:"url,omitempty"`
+	Author struct {
+		ID    int64  `json:"id"`
+		Name  string `json:"name"`
+		Email string `json:"email"`
+	} `json:"author"`
+	Subject   string `json:"subject,omitempty"`
+	Content   string `json:"content,omitempty"`
+	Cause     string `json:"cause,omitempty"`
+	CauseID   int64  `json:"cause_id,omitempty"`
+	MessageID string `json:"message_id,omitempty"`
+	// TicketID is the ticket the message was a reply to, or the ticket created by its recovery
+	TicketID      int64        `json:"ticket_id,omitempty"`
+	Recipient     string       `json:"recipient,omitempty"`
+	BrandID       int64        `json:"brand_id,omitempty"`
+	Via           *Via         `json:"via,omitempty"`
+	Attachments   []Attachment `json:"attachments,omitempty"`
+	ErrorMessages []string     `json:"error_messages,omitempty"`
+	CreatedAt     *Timestamp   `json:"created_at,omitempty"`
+	UpdatedAt     *Timestamp   `json:"updated_at,omitempty"`
+}
+
+// SuspendedTicketRecoveryError is returned when suspended tickets could not be recovered
+type SuspendedTicketRecoveryError struct {
+	Failed []SuspendedTicket
+}
+
+func (e *SuspendedTicketRecoveryError) Error() string {
+	return fmt.Sprintf("%d suspended tickets could not be recovered", len(e.Failed))
+}
+
+// SuspendedTicketAPI an interface containing the suspended ticket methods
+type SuspendedTicketAPI interface {
+	RecoverSuspendedTicket(ctx context.Context, id int64) (SuspendedTicket, error)
+	RecoverSuspendedTickets(ctx context.Context, ids []int64) ([]SuspendedTicket, error)
+	DeleteSuspendedTickets(ctx context.Context, ids []int64) error
+	GetSuspendedTicketAttachments(ctx context.Context, id int64) (Upload, error)
+}
+
+// RecoverSuspendedTicket recovers a suspended ticket. The TicketID of the result is the created ticket.
+// A *SuspendedTicketRecoveryError is returned if the ticket could not be recovered.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/suspended_tickets/#recover-suspended-ticket
+func (z *Client) RecoverSuspendedTicket(ctx context.Context, id int64) (SuspendedTicket, error) {
+	body, err := z.Put(ctx, fmt.Sprintf("/suspended_tickets/%d/recover.json", id), nil)
+	if err != nil {
+		return SuspendedTicket{}, recoveryError(err, "ticket")
+	}
+
+	tickets, err := decodeSuspendedTickets(body, "ticket")
+	if err != nil {
+		return SuspendedTicket{}, err
+	}
+	if len(tickets) == 0 {
+		return SuspendedTicket{}, fmt.Errorf("no ticket in the response to the recovery of suspended ticket %d", id)
+	}
+	return tickets[0], nil
+}
+
+// RecoverSuspendedTickets recovers suspended tickets, 100 per request. The tickets recovered are returned
+// together with a *SuspendedTicketRecoveryError holding the tickets which could not be recovered, if any.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/suspended_tickets/#recover-multiple-suspended-tickets
+func (z *Client) RecoverSuspendedTickets(ctx context.Context, ids []int64) ([]SuspendedTicket, error) {
+	var recovered, failed []SuspendedTicket
+	for offset := 0; offset < len(ids); offset += bulkLimit {
+		chunk := ids[offset:min(offset+bulkLimit, len(ids))]
+
+		body, err := z.Put(ctx, "/suspended_tickets/recover_many.json?ids="+joinIDs(chunk), nil)
+		var recoveryErr *SuspendedTicketRecoveryError
+		if errors.As(recoveryError(err, "tickets"), &recoveryErr) {
+			failed = append(failed, recoveryErr.Failed...)
+			continue
+		}
+		if err != nil {
+			return recovered, err
+		}
+
+		tickets, err := decodeSuspendedTickets(body, "tickets")
+		if err != nil {
+			return recovered, err
+		}
+		recovered = append(recovered, tickets...)
+	}
+
+	if len(failed) > 0 {
+		return recovered, &SuspendedTicketRecoveryError{Failed: failed}
+	}
+	return recovered, nil
+}
+
+// DeleteSuspendedTickets deletes suspended tickets, 100 per request
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/suspended_tickets/#delete-multiple-suspended-tickets
+func (z *Client) DeleteSuspendedTickets(ctx context.Context, ids []int64) error {
+	for offset := 0; offset < len(ids); offset += bulkLimit {
+		chunk := ids[offset:min(offset+bulkLimit, len(ids))]
+		if err := z.Delete(ctx, "/suspended_tickets/destroy_many.json?ids="+joinIDs(chunk)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetSuspendedTicketAttachments copies the attachments of a suspended ticket and returns them as an
+// upload whose token can be used on a ticket created manually from the suspended ticket
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/suspended_tickets/#suspended-ticket-attachments
+func (z *Client) GetSuspendedTicketAttachments(ctx context.Context, id int64) (Upload, error) {
+	var result struct {
+		Upload Upload `json:"upload"`
+	}
+
+	body, err := z.Post(ctx, fmt.Sprintf("/suspended_tickets/%d/attachments.json", id), nil)
+	if err != nil {
+		return Upload{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Upload{}, err
+	}
+	return result.Upload, nil
+}
+
+// recoveryError turns the 422 response listing the tickets which could not be recovered into a
+// *SuspendedTicketRecoveryError, and returns any other error as is
+func recoveryError(err error, envelope string) error {
+	var apiErr client.Error
+	if !errors.As(err, &apiErr) || apiErr.Status() != http.StatusUnprocessableEntity {
+		return err
+	}
+
+	failed, decodeErr := decodeSuspendedTickets(apiErr.ErrorBody, envelope)
+	if decodeErr != nil || len(failed) == 0 {
+		return err
+	}
+	return &SuspendedTicketRecoveryError{Failed: failed}
+}
+
+// decodeSuspendedTickets decodes the suspended tickets under envelope, which holds either a list or a
+// single ticket
+func decodeSuspendedTickets(body []byte, envelope string) ([]SuspendedTicket, error) {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, err
+	}
+
+	data := bytes.TrimSpace(raw[envelope])
+	if len(data) == 0 {
+		return nil, nil
+	}
+	if bytes.HasPrefix(data, []byte("{")) {
+		data = append(append([]byte("["), data...), ']')
+	}
+
+	var tickets []SuspendedTicket
+	if err := json.Unmarshal(data, &tickets); err != nil {
+		return nil, fmt.Errorf("%s: %w", envelope, err)
+	}
+	return tickets, nil
+}
@@ -0,0 +1,112 @@
+package zendesk
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/JacobPotter/go-zendesk/testhelper"
+)
+
+func TestIterateSuspendedTickets(t *testing.T) {
+	mockAPI := testhelper.NewMockAPI(t, http.MethodGet, "suspended_tickets.json")
+	c := NewTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	it := c.IterateSuspendedTickets(ctx, &SuspendedTicketIteratorOptions{
+		IteratorOptions: IteratorOptions{UseOBP: true},
+		SortBy:          "cause",
+	})
+	tickets, err := it.GetNext()
+	if err != nil {
+		t.Fatalf("Failed to list suspended tickets: %s", err)
+	}
+	if len(tickets) != 2 || tickets[0].Author.Email != "styx@example.com" || tickets[1].CauseID != 4 {
+		t.Fatalf("Unexpected suspended tickets %v", tickets)
+	}
+	if len(tickets[0].Attachments) != 1 || tickets[0].Via.Channel != "email" {
+		t.Fatalf("Unexpected attachments %v or via %v", tickets[0].Attachments, tickets[0].Via)
+	}
+}
+
+func TestIterateSuspendedTicketsRejectsSortWithCBP(t *testing.T) {
+	mockAPI := testhelper.NewMockAPI(t, http.MethodGet, "suspended_tickets.json")
+	c := NewTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	it := c.IterateSuspendedTickets(ctx, &SuspendedTicketIteratorOptions{SortOrder: "desc"})
+	if _, err := it.GetNext(); err == nil || it.HasMore() {
+		t.Fatalf("Expected an error for sort_order without UseOBP, got %v", err)
+	}
+}
+
+func TestRecoverSuspendedTicketsSurfacesFailures(t *testing.T) {
+	requests := 0
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Method != http.MethodPut || r.URL.Path != "/suspended_tickets/recover_many.json" {
+			t.Fatalf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if requests == 2 {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+		}
+		_, err := w.Write(testhelper.ReadFixture(t, filepath.Join(http.MethodPut, "recover_suspended_tickets.json")))
+		if err != nil {
+			t.Fatalf("Failed to write fixture: %s", err)
+		}
+	}))
+	c := NewTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	ids := make([]int64, 150)
+	for i := range ids {
+		ids[i] = int64(3000 + i)
+	}
+
+	recovered, err := c.RecoverSuspendedTickets(ctx, ids)
+	var recoveryErr *SuspendedTicketRecoveryError
+	if !errors.As(err, &recoveryErr) || len(recoveryErr.Failed) != 1 {
+		t.Fatalf("Expected a recovery error, got %v", err)
+	}
+	if requests != 2 || len(recovered) != 1 || recovered[0].TicketID != 67890 {
+		t.Fatalf("Unexpected recovered tickets %v after %d requests", recovered, requests)
+	}
+}
+
+func TestRecoverSuspendedTicket(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/suspended_tickets/3436/recover.json" {
+			t.Fatalf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		_, err := w.Write([]byte(`{"ticket": [{"id": 3436, "ticket_id": 67890}]}`))
+		if err != nil {
+			t.Fatalf("Failed to write response: %s", err)
+		}
+	}))
+	c := NewTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	ticket, err := c.RecoverSuspendedTicket(ctx, 3436)
+	if err != nil {
+		t.Fatalf("Failed to recover suspended ticket: %s", err)
+	}
+	if ticket.TicketID != 67890 {
+		t.Fatalf("Unexpected ticket %v", ticket)
+	}
+}
+
+func TestGetSuspendedTicketAttachments(t *testing.T) {
+	mockAPI := testhelper.NewMockAPI(t, http.MethodPost, "suspended_ticket_attachments.json")
+	c := NewTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	upload, err := c.GetSuspendedTicketAttachments(ctx, 3436)
+	if err != nil {
+		t.Fatalf("Failed to get suspended ticket attachments: %s", err)
+	}
+	if upload.Token != "yrznqgjoa24iw2f" || len(upload.Attachments) != 1 {
+		t.Fatalf("Unexpected upload %v", upload)
+	}
+}
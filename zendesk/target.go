@@ -4,14 +4,13 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"time"
 )
 
 // Target is struct for target payload
 type Target struct {
 	URL       string     `json:"url,omitempty"`
 	ID        int64      `json:"id,omitempty"`
-	CreatedAt *time.Time `json:"created_at,omitempty"`
+	CreatedAt *Timestamp `json:"created_at,omitempty"`
 	Type      string     `json:"type"`
 	Title     string     `json:"title"`
 	Active    bool       `json:"active"`
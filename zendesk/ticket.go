@@ -3,51 +3,13 @@ package zendesk
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/JacobPotter/go-zendesk/client"
 	"strconv"
 	"strings"
-	"time"
 )
 
-type CustomField struct {
-	ID int64 `json:"id"`
-	// Valid types are string or []string.
-	Value interface{} `json:"value"`
-}
-
-// UnmarshalJSON Custom Unmarshal function required because a custom field's value can be
-// a string or array of strings.
-func (cf *CustomField) UnmarshalJSON(data []byte) error {
-	var temp map[string]interface{}
-	if err := json.Unmarshal(data, &temp); err != nil {
-		return err
-	}
-
-	cf.ID = int64(temp["id"].(float64))
-
-	switch v := temp["value"].(type) {
-	case string, nil, bool:
-		cf.Value = v
-	case []interface{}:
-		var list []string
-
-		for _, v := range temp["value"].([]interface{}) {
-			if s, ok := v.(string); ok {
-				list = append(list, s)
-			} else {
-				return fmt.Errorf("%T is an invalid type for custom field value", v)
-			}
-		}
-
-		cf.Value = list
-	default:
-		return fmt.Errorf("%T is an invalid type for custom field value", v)
-	}
-
-	return nil
-}
-
 type Ticket struct {
 	ID              int64         `json:"id,omitempty"`
 	URL             string        `json:"url,omitempty"`
@@ -71,17 +33,13 @@ type Ticket struct {
 	ForumTopicID    int64         `json:"forum_topic_id,omitempty"`
 	ProblemID       int64         `json:"problem_id,omitempty"`
 	HasIncidents    bool          `json:"has_incidents,omitempty"`
-	DueAt           *time.Time    `json:"due_at,omitempty"`
+	DueAt           *Timestamp    `json:"due_at,omitempty"`
 	Tags            []string      `json:"tags,omitempty"`
 	CustomFields    []CustomField `json:"custom_fields,omitempty"`
 
 	Via *Via `json:"via,omitempty"`
 
-	SatisfactionRating *struct {
-		ID      int64  `json:"id"`
-		Score   string `json:"score"`
-		Comment string `json:"comment"`
-	} `json:"satisfaction_rating,omitempty"`
+	SatisfactionRating *SatisfactionRating `json:"satisfaction_rating,omitempty"`
 
 	SharingAgreementIDs []int64    `json:"sharing_agreement_ids,omitempty"`
 	FollowupIDs         []int64    `json:"followup_ids,omitempty"`
@@ -92,8 +50,8 @@ type Ticket struct {
 	AllowChannelback    bool       `json:"allow_channelback,omitempty"`
 	AllowAttachments    bool       `json:"allow_attachments,omitempty"`
 	IsPublic            bool       `json:"is_public,omitempty"`
-	CreatedAt           *time.Time `json:"created_at,omitempty"`
-	UpdatedAt           *time.Time `json:"updated_at,omitempty"`
+	CreatedAt           *Timestamp `json:"created_at,omitempty"`
+	UpdatedAt           *Timestamp `json:"updated_at,omitempty"`
 
 	// Collaborators is POST only
 	Collaborators *Collaborators `json:"collaborators,omitempty"`
@@ -106,7 +64,7 @@ type Ticket struct {
 
 	// safe update fields
 	// https://developer.zendesk.com/documentation/ticketing/managing-tickets/creating-and-updating-tickets/#protecting-against-ticket-update-collisions
-	UpdatedStamp *time.Time `json:"updated_stamp,omitempty"`
+	UpdatedStamp *Timestamp `json:"updated_stamp,omitempty"`
 	SafeUpdate   bool       `json:"safe_update,omitempty"`
 
 	// TODO: TicketAudit (POST only) #126
@@ -145,6 +103,78 @@ type TicketListOptions struct {
 	SortOrder string `url:"sort_order,omitempty"`
 }
 
+// ticketSortByValues are the OBP sort_by values accepted by the ticket list endpoints
+var ticketSortByValues = []string{
+	"assignee", "assignee.name", "created_at", "group", "id",
+	"locale", "requester", "requester.name", "status", "subject", "updated_at",
+}
+
+// TicketIteratorOptions are the options accepted by IterateTickets
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/tickets/#list-tickets
+type TicketIteratorOptions struct {
+	IteratorOptions
+
+	// Sort is used by CBP and can take "id", "status" or "updated_at",
+	// prefixed with "-" for descending order
+	Sort string `url:"sort,omitempty"`
+
+	// SortBy is used by OBP and can take "assignee", "assignee.name", "created_at", "group", "id",
+	// "locale", "requester", "requester.name", "status", "subject", "updated_at"
+	SortBy string `url:"sort_by,omitempty"`
+
+	// SortOrder is used by OBP and can take "asc" or "desc"
+	SortOrder string `url:"sort_order,omitempty"`
+
+	ExternalID string `url:"external_id,omitempty"`
+
+	// Include can take a comma separated list of users, groups, organizations, brands, metric_sets,
+	// comment_count and dates. The side-loads of each page are returned by Iterator.Sideloads.
+	Include string `url:"include,omitempty"`
+}
+
+// Validate checks the sort and include options of TicketIteratorOptions
+func (o TicketIteratorOptions) Validate() error {
+	return errors.Join(
+		o.validateSortMode(o.Sort, o.SortBy, o.SortOrder),
+		validateSort(o.Sort, "id", "status", "updated_at"),
+		validateOption("sort_by", o.SortBy, ticketSortByValues...),
+		validateOption("sort_order", o.SortOrder, "asc", "desc"),
+		validateInclude(o.Include, ticketSideloads...),
+	)
+}
+
+// OrganizationTicketIteratorOptions are the options accepted by IterateOrganizationTickets
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/tickets/#list-tickets
+type OrganizationTicketIteratorOptions struct {
+	IteratorOptions
+
+	// OrganizationID is required
+	OrganizationID int64 `url:"-"`
+
+	// Sort is used by CBP and can take "id", "status" or "updated_at",
+	// prefixed with "-" for descending order
+	Sort string `url:"sort,omitempty"`
+
+	// SortBy is used by OBP and takes the same values as TicketIteratorOptions.SortBy
+	SortBy string `url:"sort_by,omitempty"`
+
+	// SortOrder is used by OBP and can take "asc" or "desc"
+	SortOrder string `url:"sort_order,omitempty"`
+}
+
+// Validate checks the organization id and sort options of OrganizationTicketIteratorOptions
+func (o OrganizationTicketIteratorOptions) Validate() error {
+	return errors.Join(
+		requireID("organization_id", o.OrganizationID),
+		o.validateSortMode(o.Sort, o.SortBy, o.SortOrder),
+		validateSort(o.Sort, "id", "status", "updated_at"),
+		validateOption("sort_by", o.SortBy, ticketSortByValues...),
+		validateOption("sort_order", o.SortOrder, "asc", "desc"),
+	)
+}
+
 // TicketListCBPResult struct represents the result of a ticket list operation in CBP. It includes an array of Ticket objects, and Meta that holds pagination metadata.
 type TicketListCBPResult struct {
 	Tickets []Ticket                    `json:"tickets"`
@@ -161,11 +191,20 @@ type TicketAPI interface {
 	GetOrganizationTicketsOBP(ctx context.Context, opts *OBPOptions) ([]Ticket, Page, error)
 	GetOrganizationTicketsCBP(ctx context.Context, opts *CBPOptions) ([]Ticket, client.CursorPaginationMeta, error)
 	GetOrganizationTicketsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[Ticket]
+	IterateTickets(ctx context.Context, opts *TicketIteratorOptions) *Iterator[Ticket]
+	IterateOrganizationTickets(ctx context.Context, opts *OrganizationTicketIteratorOptions) *Iterator[Ticket]
 	GetTicket(ctx context.Context, id int64) (Ticket, error)
+	GetTicketWithSideloads(ctx context.Context, ticketID int64, include string) (Ticket, Sideloads, error)
 	GetMultipleTickets(ctx context.Context, ticketIDs []int64) ([]Ticket, error)
 	CreateTicket(ctx context.Context, ticket Ticket) (Ticket, error)
 	UpdateTicket(ctx context.Context, ticketID int64, ticket Ticket) (Ticket, error)
+	UpdateTicketWith(ctx context.Context, ticketID int64, update *TicketUpdate) (Ticket, error)
+	UpdateTicketSafely(ctx context.Context, ticketID int64, mutate func(*Ticket) error) (Ticket, error)
 	DeleteTicket(ctx context.Context, ticketID int64) error
+	MergeTickets(ctx context.Context, targetID int64, sourceIDs []int64, opts MergeOptions) (JobStatus, error)
+	MergeTicketsAndWait(
+		ctx context.Context, targetID int64, sourceIDs []int64, opts MergeOptions, waitOpts *JobWaitOptions,
+	) (Ticket, error)
 }
 
 // GetTickets get ticket list with offset based pagination
@@ -199,6 +238,30 @@ func (z *Client) GetTickets(ctx context.Context, opts *TicketListOptions) ([]Tic
 	return data.Tickets, data.Page, nil
 }
 
+// IterateTickets returns an Iterator over all tickets
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/tickets/#list-tickets
+func (z *Client) IterateTickets(ctx context.Context, opts *TicketIteratorOptions) *Iterator[Ticket] {
+	if opts == nil {
+		opts = &TicketIteratorOptions{}
+	}
+	return newSideloadIterator[Ticket](ctx, z, opts.IteratorOptions, *opts, "/tickets.json", "tickets")
+}
+
+// IterateOrganizationTickets returns an Iterator over the tickets of an organization
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/tickets/#list-tickets
+func (z *Client) IterateOrganizationTickets(
+	ctx context.Context, opts *OrganizationTicketIteratorOptions,
+) *Iterator[Ticket] {
+	if opts == nil {
+		opts = &OrganizationTicketIteratorOptions{}
+	}
+	return newListIterator(
+		ctx, opts.IteratorOptions, *opts, opts.OrganizationID, z.GetOrganizationTicketsOBP, z.GetOrganizationTicketsCBP,
+	)
+}
+
 // GetOrganizationTickets get organization ticket list
 //
 // ref: https://developer.zendesk.com/rest_api/docs/support/tickets#list-tickets
@@ -249,6 +312,15 @@ func (z *Client) GetTicket(ctx context.Context, ticketID int64) (Ticket, error)
 	return result.Ticket, err
 }
 
+// GetTicketWithSideloads gets a specified ticket together with the side-loads listed in include,
+// a comma separated list of users, groups, organizations, brands, metric_sets, comment_count and dates
+//
+// ref: https://developer.zendesk.com/documentation/ticketing/using-the-zendesk-api/side_loading/
+func (z *Client) GetTicketWithSideloads(ctx context.Context, ticketID int64, include string) (Ticket, Sideloads, error) {
+	return getWithSideloads[Ticket](z, ctx, fmt.Sprintf("/tickets/%d.json", ticketID), "ticket", include,
+		ticketSideloads...)
+}
+
 // GetMultipleTickets gets multiple specified tickets
 //
 // ref: https://developer.zendesk.com/rest_api/docs/support/tickets#show-multiple-tickets
@@ -305,6 +377,7 @@ func (z *Client) CreateTicket(ctx context.Context, ticket Ticket) (Ticket, error
 }
 
 // UpdateTicket update an existing ticket
+// Fields with a zero value are left out, use UpdateTicketWith to clear them.
 // ref: https://developer.zendesk.com/rest_api/docs/support/tickets#update-ticket
 func (z *Client) UpdateTicket(ctx context.Context, ticketID int64, ticket Ticket) (Ticket, error) {
 	var data, result struct {
@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/JacobPotter/go-zendesk/client"
-	"time"
 )
 
 // TicketAudit is struct for ticket_audit payload
@@ -14,9 +13,9 @@ type TicketAudit struct {
 	TicketID  int64          `json:"ticket_id,omitempty"`
 	Metadata  interface{}    `json:"metadata,omitempty"`
 	Via       TicketAuditVia `json:"via,omitempty"`
-	CreatedAt *time.Time     `json:"created_at,omitempty"`
+	CreatedAt *Timestamp     `json:"created_at,omitempty"`
 	AuthorID  int64          `json:"author_id,omitempty"`
-	Events    []interface{}  `json:"events,omitempty"`
+	Events    AuditEvents    `json:"events,omitempty"`
 }
 
 // TicketAuditVia is struct for via payload
@@ -26,6 +25,7 @@ type TicketAuditVia struct {
 		To   interface{} `json:"to,omitempty"`
 		From interface{} `json:"from,omitempty"`
 		Ref  string      `json:"ref,omitempty"`
+		Rel  string      `json:"rel,omitempty"`
 	} `json:"source,omitempty"`
 }
 
@@ -37,6 +37,7 @@ type TicketAuditAPI interface {
 	GetTicketAuditsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[TicketAudit]
 	GetTicketAuditsOBP(ctx context.Context, opts *OBPOptions) ([]TicketAudit, Page, error)
 	GetTicketAuditsCBP(ctx context.Context, opts *CBPOptions) ([]TicketAudit, client.CursorPaginationMeta, error)
+	GetTicketTimeline(ctx context.Context, ticketID int64) (*TicketTimeline, error)
 }
 
 // GetAllTicketAudits list all ticket audits
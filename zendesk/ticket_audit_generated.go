@@ -1,9 +1,9 @@
 // Code generated by Script. DO NOT EDIT.
-// Source: script/codegen/main.go
+// Source: script/codegen/resources.yaml
 //
 // Generated by this command:
 //
-//	go run script/codegen/main.go
+//	go run ./script/codegen
 
 package zendesk
 
@@ -13,6 +13,7 @@ import (
 	"github.com/JacobPotter/go-zendesk/client"
 )
 
+// GetTicketAuditsIterator returns an Iterator over /tickets/%d/audits.json
 func (z *Client) GetTicketAuditsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[TicketAudit] {
 	return &Iterator[TicketAudit]{
 		CommonOptions: opts.CommonOptions,
@@ -27,6 +28,7 @@ func (z *Client) GetTicketAuditsIterator(ctx context.Context, opts *PaginationOp
 	}
 }
 
+// GetTicketAuditsOBP fetches a page of /tickets/%d/audits.json with offset based pagination
 func (z *Client) GetTicketAuditsOBP(ctx context.Context, opts *OBPOptions) ([]TicketAudit, Page, error) {
 	var data struct {
 		TicketAudits []TicketAudit `json:"audits"`
@@ -39,19 +41,19 @@ func (z *Client) GetTicketAuditsOBP(ctx context.Context, opts *OBPOptions) ([]Ti
 	}
 
 	path := fmt.Sprintf("/tickets/%d/audits.json", tmp.Id)
-	u, err := client.AddOptions(path, tmp)
-
+	u, err := tmp.addOptions(path)
 	if err != nil {
 		return nil, Page{}, err
 	}
 
-	err = client.GetData(z, ctx, u, &data)
+	err = z.getData(ctx, u, &data)
 	if err != nil {
 		return nil, Page{}, err
 	}
 	return data.TicketAudits, data.Page, nil
 }
 
+// GetTicketAuditsCBP fetches a page of /tickets/%d/audits.json with cursor based pagination
 func (z *Client) GetTicketAuditsCBP(ctx context.Context, opts *CBPOptions) ([]TicketAudit, client.CursorPaginationMeta, error) {
 	var data struct {
 		TicketAudits []TicketAudit               `json:"audits"`
@@ -64,15 +66,35 @@ func (z *Client) GetTicketAuditsCBP(ctx context.Context, opts *CBPOptions) ([]Ti
 	}
 
 	path := fmt.Sprintf("/tickets/%d/audits.json", tmp.Id)
-	u, err := client.AddOptions(path, tmp)
-
+	u, err := tmp.addOptions(path)
 	if err != nil {
 		return nil, data.Meta, err
 	}
 
-	err = client.GetData(z, ctx, u, &data)
+	err = z.getData(ctx, u, &data)
 	if err != nil {
 		return nil, data.Meta, err
 	}
 	return data.TicketAudits, data.Meta, nil
 }
+
+// TicketAuditIteratorOptions are the options accepted by IterateTicketAudits
+type TicketAuditIteratorOptions struct {
+	IteratorOptions
+
+	// TicketID is required
+	TicketID int64 `url:"-"`
+}
+
+// Validate checks the options of TicketAuditIteratorOptions
+func (o TicketAuditIteratorOptions) Validate() error {
+	return requireID("ticket_id", o.TicketID)
+}
+
+// IterateTicketAudits returns an Iterator over /tickets/%d/audits.json
+func (z *Client) IterateTicketAudits(ctx context.Context, opts *TicketAuditIteratorOptions) *Iterator[TicketAudit] {
+	if opts == nil {
+		opts = &TicketAuditIteratorOptions{}
+	}
+	return newListIterator(ctx, opts.IteratorOptions, *opts, opts.TicketID, z.GetTicketAuditsOBP, z.GetTicketAuditsCBP)
+}
@@ -0,0 +1,141 @@
+package zendesk
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// bulkLimit is the maximum number of items accepted by one request to a bulk endpoint
+const bulkLimit = 100
+
+// TicketBulkAPI an interface containing the bulk ticket methods.
+// Inputs larger than 100 tickets are split into one job per 100 tickets.
+type TicketBulkAPI interface {
+	CreateManyTickets(ctx context.Context, tickets []Ticket) ([]BulkJob, error)
+	UpdateManyTickets(ctx context.Context, ticketIDs []int64, update Ticket) ([]BulkJob, error)
+	UpdateManyTicketsWith(ctx context.Context, ticketIDs []int64, update *TicketUpdate) ([]BulkJob, error)
+	BatchUpdateTickets(ctx context.Context, tickets []Ticket) ([]BulkJob, error)
+	DestroyManyTickets(ctx context.Context, ticketIDs []int64) ([]BulkJob, error)
+	MarkManyAsSpam(ctx context.Context, ticketIDs []int64) ([]BulkJob, error)
+}
+
+// CreateManyTickets queues the creation of tickets. The results of the jobs carry the index of
+// each ticket, use BulkJob.InputIndex to map them back to tickets.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/tickets/#create-many-tickets
+func (z *Client) CreateManyTickets(ctx context.Context, tickets []Ticket) ([]BulkJob, error) {
+	return bulk(tickets, nil, func(chunk []Ticket) (JobStatus, error) {
+		var data struct {
+			Tickets []Ticket `json:"tickets"`
+		}
+		data.Tickets = chunk
+
+		body, err := z.Post(ctx, "/tickets/create_many.json", data)
+		if err != nil {
+			return JobStatus{}, err
+		}
+		return decodeJobStatus(body)
+	})
+}
+
+// UpdateManyTickets queues the same update of every ticket in ticketIDs
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/tickets/#update-many-tickets
+func (z *Client) UpdateManyTickets(ctx context.Context, ticketIDs []int64, update Ticket) ([]BulkJob, error) {
+	return bulk(ticketIDs, ticketIDs, func(chunk []int64) (JobStatus, error) {
+		var data struct {
+			Ticket Ticket `json:"ticket"`
+		}
+		data.Ticket = update
+
+		body, err := z.Put(ctx, "/tickets/update_many.json?ids="+joinIDs(chunk), data)
+		if err != nil {
+			return JobStatus{}, err
+		}
+		return decodeJobStatus(body)
+	})
+}
+
+// BatchUpdateTickets queues an update of each ticket with its own payload. Every ticket must have an ID.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/tickets/#update-many-tickets
+func (z *Client) BatchUpdateTickets(ctx context.Context, tickets []Ticket) ([]BulkJob, error) {
+	ids := make([]int64, len(tickets))
+	for i, t := range tickets {
+		if err := requireID(fmt.Sprintf("tickets[%d].id", i), t.ID); err != nil {
+			return nil, err
+		}
+		ids[i] = t.ID
+	}
+
+	return bulk(tickets, ids, func(chunk []Ticket) (JobStatus, error) {
+		var data struct {
+			Tickets []Ticket `json:"tickets"`
+		}
+		data.Tickets = chunk
+
+		body, err := z.Put(ctx, "/tickets/update_many.json", data)
+		if err != nil {
+			return JobStatus{}, err
+		}
+		return decodeJobStatus(body)
+	})
+}
+
+// DestroyManyTickets queues the deletion of the tickets in ticketIDs
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/tickets/#bulk-delete-tickets
+func (z *Client) DestroyManyTickets(ctx context.Context, ticketIDs []int64) ([]BulkJob, error) {
+	return bulk(ticketIDs, ticketIDs, func(chunk []int64) (JobStatus, error) {
+		body, err := z.DeleteWithBody(ctx, "/tickets/destroy_many.json?ids="+joinIDs(chunk))
+		if err != nil {
+			return JobStatus{}, err
+		}
+		return decodeJobStatus(body)
+	})
+}
+
+// MarkManyAsSpam queues marking the tickets in ticketIDs as spam and suspending their requesters
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/tickets/#bulk-mark-tickets-as-spam
+func (z *Client) MarkManyAsSpam(ctx context.Context, ticketIDs []int64) ([]BulkJob, error) {
+	return bulk(ticketIDs, ticketIDs, func(chunk []int64) (JobStatus, error) {
+		body, err := z.Put(ctx, "/tickets/mark_many_as_spam.json?ids="+joinIDs(chunk), nil)
+		if err != nil {
+			return JobStatus{}, err
+		}
+		return decodeJobStatus(body)
+	})
+}
+
+// bulk calls queue for every chunk of at most bulkLimit items. ids holds the id of every item, if any,
+// so that job results reporting ids can be mapped back to the input. The jobs queued before an error
+// are returned together with the error.
+func bulk[T any](items []T, ids []int64, queue func(chunk []T) (JobStatus, error)) ([]BulkJob, error) {
+	var jobs []BulkJob
+	for offset := 0; offset < len(items); offset += bulkLimit {
+		end := min(offset+bulkLimit, len(items))
+
+		var chunkIDs []int64
+		if ids != nil {
+			chunkIDs = ids[offset:end]
+		}
+
+		status, err := queue(items[offset:end])
+		if err != nil {
+			return jobs, err
+		}
+		jobs = append(jobs, BulkJob{JobStatus: status, Offset: offset, IDs: chunkIDs})
+	}
+	return jobs, nil
+}
+
+func joinIDs(ids []int64) string {
+	s := make([]string, len(ids))
+	for i, id := range ids {
+		s[i] = strconv.FormatInt(id, 10)
+	}
+	return strings.Join(s, ",")
+}
@@ -0,0 +1,100 @@
+package zendesk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/JacobPotter/go-zendesk/testhelper"
+)
+
+func TestCreateManyTickets(t *testing.T) {
+	mockAPI := testhelper.NewMockAPI(t, http.MethodPost, "create_many_tickets.json")
+	c := NewTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	jobs, err := c.CreateManyTickets(ctx, []Ticket{{Subject: "first"}, {Subject: "second"}})
+	if err != nil {
+		t.Fatalf("Failed to create tickets: %s", err)
+	}
+	if len(jobs) != 1 || jobs[0].Status != JobStatusCompleted {
+		t.Fatalf("Unexpected jobs %v", jobs)
+	}
+
+	failed, ok := jobs[0].InputIndex(jobs[0].Results[1])
+	if !ok || failed != 1 || jobs[0].Results[1].Error != "TicketCreateFailed" {
+		t.Fatalf("Unexpected result %v mapped to %d", jobs[0].Results[1], failed)
+	}
+}
+
+func TestUpdateManyTicketsChunks(t *testing.T) {
+	var chunks [][]string
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/tickets/update_many.json" {
+			t.Fatalf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		chunks = append(chunks, strings.Split(r.URL.Query().Get("ids"), ","))
+		_, err := w.Write(testhelper.ReadFixture(t, filepath.Join(http.MethodPut, "update_many_tickets.json")))
+		if err != nil {
+			t.Fatalf("Failed to write fixture: %s", err)
+		}
+	}))
+	c := NewTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	ids := make([]int64, 250)
+	for i := range ids {
+		ids[i] = int64(1000 + i)
+	}
+
+	jobs, err := c.UpdateManyTickets(ctx, ids, Ticket{Status: "solved"})
+	if err != nil {
+		t.Fatalf("Failed to update tickets: %s", err)
+	}
+	if len(chunks) != 3 || len(chunks[0]) != 100 || len(chunks[2]) != 50 || chunks[2][0] != "1200" {
+		t.Fatalf("Unexpected chunks %v", chunks)
+	}
+	if len(jobs) != 3 || jobs[2].Offset != 200 {
+		t.Fatalf("Unexpected jobs %v", jobs)
+	}
+
+	index, ok := jobs[2].InputIndex(JobResult{ID: 1210, Status: "Updated"})
+	if !ok || index != 210 {
+		t.Fatalf("Expected ticket 1210 to map to input index 210, got %d", index)
+	}
+}
+
+func TestBatchUpdateTicketsRequiresIDs(t *testing.T) {
+	mockAPI := testhelper.NewMockAPI(t, http.MethodPut, "update_many_tickets.json")
+	c := NewTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := c.BatchUpdateTickets(ctx, []Ticket{{ID: 1, Status: "open"}, {Status: "solved"}})
+	if err == nil || !strings.Contains(err.Error(), "tickets[1].id") {
+		t.Fatalf("Expected a missing id error, got %v", err)
+	}
+}
+
+func TestDestroyManyTickets(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Query().Get("ids") != "1,2" {
+			t.Fatalf("Unexpected request %s %s", r.Method, r.URL.String())
+		}
+		_, err := w.Write(testhelper.ReadFixture(t, filepath.Join(http.MethodPut, "update_many_tickets.json")))
+		if err != nil {
+			t.Fatalf("Failed to write fixture: %s", err)
+		}
+	}))
+	c := NewTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	jobs, err := c.DestroyManyTickets(ctx, []int64{1, 2})
+	if err != nil {
+		t.Fatalf("Failed to delete tickets: %s", err)
+	}
+	if len(jobs) != 1 || jobs[0].ID != "8b726e606741012ffc2d782bcb7848fe" {
+		t.Fatalf("Unexpected jobs %v", jobs)
+	}
+}
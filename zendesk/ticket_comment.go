@@ -3,9 +3,9 @@ package zendesk
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/JacobPotter/go-zendesk/client"
-	"time"
 )
 
 // TicketCommentAPI is an interface containing all ticket comment related API methods
@@ -16,6 +16,7 @@ type TicketCommentAPI interface {
 	GetTicketCommentsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[TicketComment]
 	GetTicketCommentsOBP(ctx context.Context, opts *OBPOptions) ([]TicketComment, Page, error)
 	GetTicketCommentsCBP(ctx context.Context, opts *CBPOptions) ([]TicketComment, client.CursorPaginationMeta, error)
+	IterateTicketComments(ctx context.Context, opts *TicketCommentIteratorOptions) *Iterator[TicketComment]
 }
 
 // TicketComment is a struct for ticket comment payload
@@ -30,7 +31,7 @@ type TicketComment struct {
 	Public      *bool                  `json:"public,omitempty"`
 	AuthorID    int64                  `json:"author_id,omitempty"`
 	Attachments []Attachment           `json:"attachments,omitempty"`
-	CreatedAt   time.Time              `json:"created_at,omitempty"`
+	CreatedAt   *Timestamp             `json:"created_at,omitempty"`
 	Uploads     []string               `json:"uploads,omitempty"`
 	Metadata    map[string]interface{} `json:"metadata,omitempty"`
 
@@ -115,6 +116,37 @@ type ListTicketCommentsOptions struct {
 	Sort                listTicketCommentsSort `url:"sort,omitempty"`
 }
 
+// TicketCommentIteratorOptions are the options accepted by IterateTicketComments
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/ticket_comments/#list-comments
+type TicketCommentIteratorOptions struct {
+	IteratorOptions
+
+	// TicketID is required
+	TicketID int64 `url:"-"`
+
+	// Include can take "users"
+	Include             string `url:"include,omitempty"`
+	IncludeInlineImages bool   `url:"include_inline_images,omitempty"`
+
+	// Sort is used by CBP and can take "created_at" or "-created_at"
+	Sort listTicketCommentsSort `url:"sort,omitempty"`
+
+	// SortOrder is used by OBP and can take "asc" or "desc"
+	SortOrder string `url:"sort_order,omitempty"`
+}
+
+// Validate checks the ticket id, include and sort options of TicketCommentIteratorOptions
+func (o TicketCommentIteratorOptions) Validate() error {
+	return errors.Join(
+		requireID("ticket_id", o.TicketID),
+		validateOption("include", o.Include, "users"),
+		o.validateSortMode(string(o.Sort), "", o.SortOrder),
+		validateSort(string(o.Sort), string(TicketCommentCreatedAtAsc)),
+		validateOption("sort_order", o.SortOrder, "asc", "desc"),
+	)
+}
+
 // ListTicketCommentsResult contains the resulting ticket comments
 // and cursor pagination metadata.
 type ListTicketCommentsResult struct {
@@ -123,6 +155,20 @@ type ListTicketCommentsResult struct {
 	Users          []User                      `json:"users"`
 }
 
+// IterateTicketComments returns an Iterator over the comments of a ticket
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/ticket_comments/#list-comments
+func (z *Client) IterateTicketComments(
+	ctx context.Context, opts *TicketCommentIteratorOptions,
+) *Iterator[TicketComment] {
+	if opts == nil {
+		opts = &TicketCommentIteratorOptions{}
+	}
+	return newListIterator(
+		ctx, opts.IteratorOptions, *opts, opts.TicketID, z.GetTicketCommentsOBP, z.GetTicketCommentsCBP,
+	)
+}
+
 // ListTicketComments gets a list of comment for a specified ticket
 //
 // ref: https://developer.zendesk.com/rest_api/docs/support/ticket_comments#list-comments
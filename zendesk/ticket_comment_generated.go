@@ -1,9 +1,9 @@
 // Code generated by Script. DO NOT EDIT.
-// Source: script/codegen/main.go
+// Source: script/codegen/resources.yaml
 //
 // Generated by this command:
 //
-//	go run script/codegen/main.go
+//	go run ./script/codegen
 
 package zendesk
 
@@ -13,6 +13,7 @@ import (
 	"github.com/JacobPotter/go-zendesk/client"
 )
 
+// GetTicketCommentsIterator returns an Iterator over /tickets/%d/comments.json
 func (z *Client) GetTicketCommentsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[TicketComment] {
 	return &Iterator[TicketComment]{
 		CommonOptions: opts.CommonOptions,
@@ -27,6 +28,7 @@ func (z *Client) GetTicketCommentsIterator(ctx context.Context, opts *Pagination
 	}
 }
 
+// GetTicketCommentsOBP fetches a page of /tickets/%d/comments.json with offset based pagination
 func (z *Client) GetTicketCommentsOBP(ctx context.Context, opts *OBPOptions) ([]TicketComment, Page, error) {
 	var data struct {
 		TicketComments []TicketComment `json:"comments"`
@@ -39,19 +41,19 @@ func (z *Client) GetTicketCommentsOBP(ctx context.Context, opts *OBPOptions) ([]
 	}
 
 	path := fmt.Sprintf("/tickets/%d/comments.json", tmp.Id)
-	u, err := client.AddOptions(path, tmp)
-
+	u, err := tmp.addOptions(path)
 	if err != nil {
 		return nil, Page{}, err
 	}
 
-	err = client.GetData(z, ctx, u, &data)
+	err = z.getData(ctx, u, &data)
 	if err != nil {
 		return nil, Page{}, err
 	}
 	return data.TicketComments, data.Page, nil
 }
 
+// GetTicketCommentsCBP fetches a page of /tickets/%d/comments.json with cursor based pagination
 func (z *Client) GetTicketCommentsCBP(ctx context.Context, opts *CBPOptions) ([]TicketComment, client.CursorPaginationMeta, error) {
 	var data struct {
 		TicketComments []TicketComment             `json:"comments"`
@@ -64,13 +66,12 @@ func (z *Client) GetTicketCommentsCBP(ctx context.Context, opts *CBPOptions) ([]
 	}
 
 	path := fmt.Sprintf("/tickets/%d/comments.json", tmp.Id)
-	u, err := client.AddOptions(path, tmp)
-
+	u, err := tmp.addOptions(path)
 	if err != nil {
 		return nil, data.Meta, err
 	}
 
-	err = client.GetData(z, ctx, u, &data)
+	err = z.getData(ctx, u, &data)
 	if err != nil {
 		return nil, data.Meta, err
 	}
@@ -2,11 +2,9 @@ package zendesk
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"github.com/JacobPotter/go-zendesk/client"
 	"slices"
-	"time"
 )
 
 // TicketFieldSystemFieldOption is struct for value of `system_field_options`
@@ -39,13 +37,16 @@ type TicketField struct {
 	EditableInPortal    bool                           `json:"editable_in_portal"`
 	RequiredInPortal    bool                           `json:"required_in_portal"`
 	Tag                 string                         `json:"tag,omitempty"`
-	CreatedAt           *time.Time                     `json:"created_at,omitempty"`
-	UpdatedAt           *time.Time                     `json:"updated_at,omitempty"`
+	CreatedAt           *Timestamp                     `json:"created_at,omitempty"`
+	UpdatedAt           *Timestamp                     `json:"updated_at,omitempty"`
 	SystemFieldOptions  []TicketFieldSystemFieldOption `json:"system_field_options,omitempty"`
 	CustomFieldOptions  []CustomFieldOption            `json:"custom_field_options,omitempty"`
 	SubTypeID           int64                          `json:"sub_type_id,omitempty"`
 	Removable           bool                           `json:"removable,omitempty"`
 	AgentDescription    string                         `json:"agent_description,omitempty"`
+
+	// Extra keeps the ticket field attributes this struct lacks, see SetKeepExtraFields
+	Extra ExtraFields `json:"-"`
 }
 
 func (f TicketField) Validate() error {
@@ -85,6 +86,7 @@ const (
 	PartialCreditCard TicketFieldType = "partial_credit_card"
 	Multiselect       TicketFieldType = "multiselect"
 	Tagger            TicketFieldType = "tagger"
+	Lookup            TicketFieldType = "lookup"
 )
 
 // TicketFieldsTypes is a slice containing all valid ticket field names.
@@ -119,6 +121,7 @@ var ValidTicketFieldsTypes = TicketFieldsTypes{
 	PartialCreditCard,
 	Multiselect,
 	Tagger,
+	Lookup,
 }
 
 // TicketFieldAPI an interface containing all the ticket field related zendesk methods
@@ -146,7 +149,7 @@ func (z *Client) GetTicketFields(ctx context.Context) ([]TicketField, Page, erro
 		return []TicketField{}, Page{}, err
 	}
 
-	err = json.Unmarshal(body, &data)
+	err = z.unmarshal(body, &data)
 	if err != nil {
 		return []TicketField{}, Page{}, err
 	}
@@ -161,12 +164,12 @@ func (z *Client) CreateTicketField(ctx context.Context, ticketField TicketField)
 	}
 	data.TicketField = ticketField
 
-	body, err := z.Post(ctx, "/ticket_fields.json", data)
+	body, err := z.Post(ctx, "/ticket_fields.json", withExtraFields(data))
 	if err != nil {
 		return TicketField{}, err
 	}
 
-	err = json.Unmarshal(body, &result)
+	err = z.unmarshal(body, &result)
 	if err != nil {
 		return TicketField{}, err
 	}
@@ -186,7 +189,7 @@ func (z *Client) GetTicketField(ctx context.Context, ticketID int64) (TicketFiel
 		return TicketField{}, err
 	}
 
-	err = json.Unmarshal(body, &result)
+	err = z.unmarshal(body, &result)
 	if err != nil {
 		return TicketField{}, err
 	}
@@ -203,13 +206,13 @@ func (z *Client) UpdateTicketField(ctx context.Context, ticketID int64, field Ti
 
 	data.TicketField = field
 
-	body, err := z.Put(ctx, fmt.Sprintf("/ticket_fields/%d.json", ticketID), data)
+	body, err := z.Put(ctx, fmt.Sprintf("/ticket_fields/%d.json", ticketID), withExtraFields(data))
 
 	if err != nil {
 		return TicketField{}, err
 	}
 
-	err = json.Unmarshal(body, &result)
+	err = z.unmarshal(body, &result)
 	if err != nil {
 		return TicketField{}, err
 	}
@@ -1,9 +1,9 @@
 // Code generated by Script. DO NOT EDIT.
-// Source: script/codegen/main.go
+// Source: script/codegen/resources.yaml
 //
 // Generated by this command:
 //
-//	go run script/codegen/main.go
+//	go run ./script/codegen
 
 package zendesk
 
@@ -12,6 +12,7 @@ import (
 	"github.com/JacobPotter/go-zendesk/client"
 )
 
+// GetTicketFieldsIterator returns an Iterator over /ticket_fields.json
 func (z *Client) GetTicketFieldsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[TicketField] {
 	return &Iterator[TicketField]{
 		CommonOptions: opts.CommonOptions,
@@ -26,6 +27,7 @@ func (z *Client) GetTicketFieldsIterator(ctx context.Context, opts *PaginationOp
 	}
 }
 
+// GetTicketFieldsOBP fetches a page of /ticket_fields.json with offset based pagination
 func (z *Client) GetTicketFieldsOBP(ctx context.Context, opts *OBPOptions) ([]TicketField, Page, error) {
 	var data struct {
 		TicketFields []TicketField `json:"ticket_fields"`
@@ -37,19 +39,19 @@ func (z *Client) GetTicketFieldsOBP(ctx context.Context, opts *OBPOptions) ([]Ti
 		tmp = &OBPOptions{}
 	}
 
-	u, err := client.AddOptions("/ticket_fields.json", tmp)
-
+	u, err := tmp.addOptions("/ticket_fields.json")
 	if err != nil {
 		return nil, Page{}, err
 	}
 
-	err = client.GetData(z, ctx, u, &data)
+	err = z.getData(ctx, u, &data)
 	if err != nil {
 		return nil, Page{}, err
 	}
 	return data.TicketFields, data.Page, nil
 }
 
+// GetTicketFieldsCBP fetches a page of /ticket_fields.json with cursor based pagination
 func (z *Client) GetTicketFieldsCBP(ctx context.Context, opts *CBPOptions) ([]TicketField, client.CursorPaginationMeta, error) {
 	var data struct {
 		TicketFields []TicketField               `json:"ticket_fields"`
@@ -61,15 +63,32 @@ func (z *Client) GetTicketFieldsCBP(ctx context.Context, opts *CBPOptions) ([]Ti
 		tmp = &CBPOptions{}
 	}
 
-	u, err := client.AddOptions("/ticket_fields.json", tmp)
-
+	u, err := tmp.addOptions("/ticket_fields.json")
 	if err != nil {
 		return nil, data.Meta, err
 	}
 
-	err = client.GetData(z, ctx, u, &data)
+	err = z.getData(ctx, u, &data)
 	if err != nil {
 		return nil, data.Meta, err
 	}
 	return data.TicketFields, data.Meta, nil
 }
+
+// TicketFieldIteratorOptions are the options accepted by IterateTicketFields
+type TicketFieldIteratorOptions struct {
+	IteratorOptions
+}
+
+// Validate checks the options of TicketFieldIteratorOptions
+func (o TicketFieldIteratorOptions) Validate() error {
+	return nil
+}
+
+// IterateTicketFields returns an Iterator over /ticket_fields.json
+func (z *Client) IterateTicketFields(ctx context.Context, opts *TicketFieldIteratorOptions) *Iterator[TicketField] {
+	if opts == nil {
+		opts = &TicketFieldIteratorOptions{}
+	}
+	return newListIterator(ctx, opts.IteratorOptions, *opts, 0, z.GetTicketFieldsOBP, z.GetTicketFieldsCBP)
+}
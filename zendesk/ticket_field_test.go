@@ -5,7 +5,6 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
-	"time"
 )
 
 func TestTicketField_Validate(t *testing.T) {
@@ -35,8 +34,8 @@ func TestTicketField_Validate(t *testing.T) {
 				EditableInPortal:    false,
 				RequiredInPortal:    false,
 				Tag:                 "",
-				CreatedAt:           &time.Time{},
-				UpdatedAt:           &time.Time{},
+				CreatedAt:           &Timestamp{},
+				UpdatedAt:           &Timestamp{},
 				SystemFieldOptions:  nil,
 				CustomFieldOptions:  nil,
 				SubTypeID:           0,
@@ -66,8 +65,8 @@ func TestTicketField_Validate(t *testing.T) {
 				EditableInPortal:    false,
 				RequiredInPortal:    false,
 				Tag:                 "",
-				CreatedAt:           &time.Time{},
-				UpdatedAt:           &time.Time{},
+				CreatedAt:           &Timestamp{},
+				UpdatedAt:           &Timestamp{},
 				SystemFieldOptions:  nil,
 				CustomFieldOptions:  nil,
 				SubTypeID:           0,
@@ -97,8 +96,8 @@ func TestTicketField_Validate(t *testing.T) {
 				EditableInPortal:    false,
 				RequiredInPortal:    false,
 				Tag:                 "",
-				CreatedAt:           &time.Time{},
-				UpdatedAt:           &time.Time{},
+				CreatedAt:           &Timestamp{},
+				UpdatedAt:           &Timestamp{},
 				SystemFieldOptions:  nil,
 				CustomFieldOptions:  nil,
 				SubTypeID:           0,
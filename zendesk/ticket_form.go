@@ -2,11 +2,9 @@ package zendesk
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"github.com/JacobPotter/go-zendesk/client"
 	"slices"
-	"time"
 )
 
 // TicketForm ticket forms allow an admin to define a subset of ticket fields for display to both agents and end users.
@@ -19,7 +17,7 @@ import (
 type TicketForm struct {
 	Active             bool                     `json:"active"`
 	AgentConditions    []ConditionalTicketField `json:"agent_conditions,omitempty"`
-	CreatedAt          time.Time                `json:"created_at"`
+	CreatedAt          *Timestamp               `json:"created_at,omitempty"`
 	Default            bool                     `json:"default,omitempty"`
 	DisplayName        string                   `json:"display_name"`
 	EndUserConditions  []ConditionalTicketField `json:"end_user_conditions,omitempty"`
@@ -32,8 +30,11 @@ type TicketForm struct {
 	RawName            string                   `json:"raw_name,omitempty"`
 	RestrictedBrandIds []int64                  `json:"restricted_brand_ids,omitempty"`
 	TicketFieldIds     []int64                  `json:"ticket_field_ids"`
-	UpdatedAt          time.Time                `json:"updated_at"`
+	UpdatedAt          *Timestamp               `json:"updated_at,omitempty"`
 	Url                string                   `json:"url"`
+
+	// Extra keeps the form attributes this struct lacks, see SetKeepExtraFields
+	Extra ExtraFields `json:"-"`
 }
 
 // ConditionalTicketField condition which to display fields ParentFieldId is the ticket field the condition is for,
@@ -154,7 +155,7 @@ func (z *Client) GetTicketForms(ctx context.Context, options *TicketFormListOpti
 		return []TicketForm{}, Page{}, err
 	}
 
-	err = json.Unmarshal(body, &data)
+	err = z.unmarshal(body, &data)
 	if err != nil {
 		return []TicketForm{}, Page{}, err
 	}
@@ -169,12 +170,12 @@ func (z *Client) CreateTicketForm(ctx context.Context, ticketForm TicketForm) (T
 	}
 	data.TicketForm = ticketForm
 
-	body, err := z.Post(ctx, "/ticket_forms.json", data)
+	body, err := z.Post(ctx, "/ticket_forms.json", withExtraFields(data))
 	if err != nil {
 		return TicketForm{}, err
 	}
 
-	err = json.Unmarshal(body, &result)
+	err = z.unmarshal(body, &result)
 	if err != nil {
 		return TicketForm{}, err
 	}
@@ -193,7 +194,7 @@ func (z *Client) GetTicketForm(ctx context.Context, id int64) (TicketForm, error
 		return TicketForm{}, err
 	}
 
-	err = json.Unmarshal(body, &result)
+	err = z.unmarshal(body, &result)
 	if err != nil {
 		return TicketForm{}, err
 	}
@@ -208,12 +209,12 @@ func (z *Client) UpdateTicketForm(ctx context.Context, id int64, form TicketForm
 	}
 
 	data.TicketForm = form
-	body, err := z.Put(ctx, fmt.Sprintf("/ticket_forms/%d.json", id), data)
+	body, err := z.Put(ctx, fmt.Sprintf("/ticket_forms/%d.json", id), withExtraFields(data))
 	if err != nil {
 		return TicketForm{}, err
 	}
 
-	err = json.Unmarshal(body, &result)
+	err = z.unmarshal(body, &result)
 	if err != nil {
 		return TicketForm{}, err
 	}
@@ -1,9 +1,9 @@
 // Code generated by Script. DO NOT EDIT.
-// Source: script/codegen/main.go
+// Source: script/codegen/resources.yaml
 //
 // Generated by this command:
 //
-//	go run script/codegen/main.go
+//	go run ./script/codegen
 
 package zendesk
 
@@ -12,6 +12,7 @@ import (
 	"github.com/JacobPotter/go-zendesk/client"
 )
 
+// GetTicketFormsIterator returns an Iterator over /ticket_forms.json
 func (z *Client) GetTicketFormsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[TicketForm] {
 	return &Iterator[TicketForm]{
 		CommonOptions: opts.CommonOptions,
@@ -26,6 +27,7 @@ func (z *Client) GetTicketFormsIterator(ctx context.Context, opts *PaginationOpt
 	}
 }
 
+// GetTicketFormsOBP fetches a page of /ticket_forms.json with offset based pagination
 func (z *Client) GetTicketFormsOBP(ctx context.Context, opts *OBPOptions) ([]TicketForm, Page, error) {
 	var data struct {
 		TicketForms []TicketForm `json:"ticket_forms"`
@@ -37,19 +39,19 @@ func (z *Client) GetTicketFormsOBP(ctx context.Context, opts *OBPOptions) ([]Tic
 		tmp = &OBPOptions{}
 	}
 
-	u, err := client.AddOptions("/ticket_forms.json", tmp)
-
+	u, err := tmp.addOptions("/ticket_forms.json")
 	if err != nil {
 		return nil, Page{}, err
 	}
 
-	err = client.GetData(z, ctx, u, &data)
+	err = z.getData(ctx, u, &data)
 	if err != nil {
 		return nil, Page{}, err
 	}
 	return data.TicketForms, data.Page, nil
 }
 
+// GetTicketFormsCBP fetches a page of /ticket_forms.json with cursor based pagination
 func (z *Client) GetTicketFormsCBP(ctx context.Context, opts *CBPOptions) ([]TicketForm, client.CursorPaginationMeta, error) {
 	var data struct {
 		TicketForms []TicketForm                `json:"ticket_forms"`
@@ -61,13 +63,12 @@ func (z *Client) GetTicketFormsCBP(ctx context.Context, opts *CBPOptions) ([]Tic
 		tmp = &CBPOptions{}
 	}
 
-	u, err := client.AddOptions("/ticket_forms.json", tmp)
-
+	u, err := tmp.addOptions("/ticket_forms.json")
 	if err != nil {
 		return nil, data.Meta, err
 	}
 
-	err = client.GetData(z, ctx, u, &data)
+	err = z.getData(ctx, u, &data)
 	if err != nil {
 		return nil, data.Meta, err
 	}
@@ -1,9 +1,9 @@
 // Code generated by Script. DO NOT EDIT.
-// Source: script/codegen/main.go
+// Source: script/codegen/resources.yaml
 //
 // Generated by this command:
 //
-//	go run script/codegen/main.go
+//	go run ./script/codegen
 
 package zendesk
 
@@ -12,6 +12,7 @@ import (
 	"github.com/JacobPotter/go-zendesk/client"
 )
 
+// GetTicketsIterator returns an Iterator over /tickets.json
 func (z *Client) GetTicketsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[Ticket] {
 	return &Iterator[Ticket]{
 		CommonOptions: opts.CommonOptions,
@@ -26,6 +27,7 @@ func (z *Client) GetTicketsIterator(ctx context.Context, opts *PaginationOptions
 	}
 }
 
+// GetTicketsOBP fetches a page of /tickets.json with offset based pagination
 func (z *Client) GetTicketsOBP(ctx context.Context, opts *OBPOptions) ([]Ticket, Page, error) {
 	var data struct {
 		Tickets []Ticket `json:"tickets"`
@@ -37,19 +39,19 @@ func (z *Client) GetTicketsOBP(ctx context.Context, opts *OBPOptions) ([]Ticket,
 		tmp = &OBPOptions{}
 	}
 
-	u, err := client.AddOptions("/tickets.json", tmp)
-
+	u, err := tmp.addOptions("/tickets.json")
 	if err != nil {
 		return nil, Page{}, err
 	}
 
-	err = client.GetData(z, ctx, u, &data)
+	err = z.getData(ctx, u, &data)
 	if err != nil {
 		return nil, Page{}, err
 	}
 	return data.Tickets, data.Page, nil
 }
 
+// GetTicketsCBP fetches a page of /tickets.json with cursor based pagination
 func (z *Client) GetTicketsCBP(ctx context.Context, opts *CBPOptions) ([]Ticket, client.CursorPaginationMeta, error) {
 	var data struct {
 		Tickets []Ticket                    `json:"tickets"`
@@ -61,13 +63,12 @@ func (z *Client) GetTicketsCBP(ctx context.Context, opts *CBPOptions) ([]Ticket,
 		tmp = &CBPOptions{}
 	}
 
-	u, err := client.AddOptions("/tickets.json", tmp)
-
+	u, err := tmp.addOptions("/tickets.json")
 	if err != nil {
 		return nil, data.Meta, err
 	}
 
-	err = client.GetData(z, ctx, u, &data)
+	err = z.getData(ctx, u, &data)
 	if err != nil {
 		return nil, data.Meta, err
 	}
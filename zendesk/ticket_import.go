@@ -0,0 +1,94 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/JacobPotter/go-zendesk/client"
+)
+
+// TicketImport is a ticket created through the Ticket Import API, which keeps the original
+// timestamps and authors and does not run triggers.
+//
+// CreatedAt, UpdatedAt and the fields of the embedded Ticket are imported as is. Comments are added in
+// order with their CreatedAt, AuthorID, Public and Uploads; a zero CreatedAt uses the import time.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/ticket_import/
+type TicketImport struct {
+	Ticket
+
+	Comments []TicketComment `json:"comments,omitempty"`
+	SolvedAt *Timestamp      `json:"solved_at,omitempty"`
+}
+
+// ImportOptions are the options accepted by ImportTicket and ImportManyTickets
+type ImportOptions struct {
+	// ArchiveImmediately archives closed tickets on import instead of keeping them in the active set
+	ArchiveImmediately bool `url:"archive_immediately,omitempty"`
+}
+
+// TicketImportAPI an interface containing the ticket import methods
+type TicketImportAPI interface {
+	ImportTicket(ctx context.Context, ticket TicketImport, opts *ImportOptions) (Ticket, error)
+	ImportManyTickets(ctx context.Context, tickets []TicketImport, opts *ImportOptions) ([]BulkJob, error)
+}
+
+// ImportTicket imports a ticket with its comments
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/ticket_import/#ticket-import
+func (z *Client) ImportTicket(ctx context.Context, ticket TicketImport, opts *ImportOptions) (Ticket, error) {
+	var data struct {
+		Ticket TicketImport `json:"ticket"`
+	}
+	var result struct {
+		Ticket Ticket `json:"ticket"`
+	}
+	data.Ticket = ticket
+
+	u, err := importPath("/imports/tickets.json", opts)
+	if err != nil {
+		return Ticket{}, err
+	}
+
+	body, err := z.Post(ctx, u, data)
+	if err != nil {
+		return Ticket{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Ticket{}, err
+	}
+	return result.Ticket, nil
+}
+
+// ImportManyTickets queues the import of tickets, one job per 100 tickets. The results of the jobs carry
+// the index of each ticket, use BulkJob.InputIndex to map them back to tickets.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/ticket_import/#ticket-bulk-import
+func (z *Client) ImportManyTickets(ctx context.Context, tickets []TicketImport, opts *ImportOptions) ([]BulkJob, error) {
+	u, err := importPath("/imports/tickets/create_many.json", opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return bulk(tickets, nil, func(chunk []TicketImport) (JobStatus, error) {
+		var data struct {
+			Tickets []TicketImport `json:"tickets"`
+		}
+		data.Tickets = chunk
+
+		body, err := z.Post(ctx, u, data)
+		if err != nil {
+			return JobStatus{}, err
+		}
+		return decodeJobStatus(body)
+	})
+}
+
+func importPath(path string, opts *ImportOptions) (string, error) {
+	if opts == nil {
+		opts = &ImportOptions{}
+	}
+	return client.AddOptions(path, opts)
+}
@@ -0,0 +1,82 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestImportTicket(t *testing.T) {
+	var request struct {
+		Ticket map[string]interface{} `json:"ticket"`
+	}
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/imports/tickets.json" || r.URL.Query().Get("archive_immediately") != "true" {
+			t.Fatalf("Unexpected request %s", r.URL.String())
+		}
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			t.Fatalf("Failed to decode import request: %s", err)
+		}
+		_, _ = w.Write([]byte(`{"ticket": {"id": 42, "subject": "Imported"}}`))
+	}))
+	c := NewTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	createdAt := NewTimestamp(time.Date(2019, 3, 1, 10, 0, 0, 0, time.UTC))
+	public := false
+	ticket, err := c.ImportTicket(ctx, TicketImport{
+		Ticket: Ticket{Subject: "Imported", RequesterID: 7, Status: "closed", CreatedAt: createdAt},
+		Comments: []TicketComment{
+			{Body: "Original question", AuthorID: 7, CreatedAt: createdAt},
+			{Body: "Internal note", AuthorID: 8, Public: &public},
+		},
+	}, &ImportOptions{ArchiveImmediately: true})
+	if err != nil {
+		t.Fatalf("Failed to import ticket: %s", err)
+	}
+	if ticket.ID != 42 {
+		t.Fatalf("Unexpected ticket %v", ticket)
+	}
+
+	if request.Ticket["subject"] != "Imported" || request.Ticket["created_at"] != "2019-03-01T10:00:00Z" {
+		t.Fatalf("Unexpected ticket payload %v", request.Ticket)
+	}
+	comments, _ := request.Ticket["comments"].([]interface{})
+	if len(comments) != 2 {
+		t.Fatalf("Unexpected comments %v", request.Ticket["comments"])
+	}
+	first := comments[0].(map[string]interface{})
+	second := comments[1].(map[string]interface{})
+	if first["created_at"] != "2019-03-01T10:00:00Z" || first["author_id"] != float64(7) {
+		t.Fatalf("Unexpected first comment %v", first)
+	}
+	if _, ok := second["created_at"]; ok || second["public"] != false {
+		t.Fatalf("Unexpected second comment %v", second)
+	}
+}
+
+func TestImportManyTickets(t *testing.T) {
+	var sizes []int
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			Tickets []json.RawMessage `json:"tickets"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			t.Fatalf("Failed to decode import request: %s", err)
+		}
+		sizes = append(sizes, len(request.Tickets))
+		_, _ = w.Write([]byte(`{"job_status": {"id": "import-job", "status": "queued"}}`))
+	}))
+	c := NewTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	jobs, err := c.ImportManyTickets(ctx, make([]TicketImport, 150), nil)
+	if err != nil {
+		t.Fatalf("Failed to import tickets: %s", err)
+	}
+	if len(jobs) != 2 || len(sizes) != 2 || sizes[0] != 100 || sizes[1] != 50 || jobs[1].Offset != 100 {
+		t.Fatalf("Unexpected jobs %v for chunks %v", jobs, sizes)
+	}
+}
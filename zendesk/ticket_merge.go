@@ -0,0 +1,74 @@
+package zendesk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// maxMergeSources is the number of tickets which can be merged into a target ticket at once
+const maxMergeSources = 5
+
+// MergeOptions are the comments added by MergeTickets. The comments are private unless the
+// matching IsPublic field is set.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/tickets/#merge-tickets-into-target-ticket
+type MergeOptions struct {
+	TargetComment         string `json:"target_comment,omitempty"`
+	SourceComment         string `json:"source_comment,omitempty"`
+	TargetCommentIsPublic bool   `json:"target_comment_is_public"`
+	SourceCommentIsPublic bool   `json:"source_comment_is_public"`
+}
+
+// MergeTickets queues the merge of the source tickets into the target ticket and returns the job status.
+// At most 5 tickets can be merged at once.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/tickets/#merge-tickets-into-target-ticket
+func (z *Client) MergeTickets(ctx context.Context, targetID int64, sourceIDs []int64, opts MergeOptions) (JobStatus, error) {
+	if err := validateMerge(targetID, sourceIDs); err != nil {
+		return JobStatus{}, err
+	}
+
+	data := struct {
+		IDs []int64 `json:"ids"`
+		MergeOptions
+	}{IDs: sourceIDs, MergeOptions: opts}
+
+	body, err := z.Post(ctx, fmt.Sprintf("/tickets/%d/merge.json", targetID), data)
+	if err != nil {
+		return JobStatus{}, err
+	}
+	return decodeJobStatus(body)
+}
+
+// MergeTicketsAndWait merges the source tickets into the target ticket like MergeTickets, waits for the
+// merge job to complete and returns the refreshed target ticket.
+func (z *Client) MergeTicketsAndWait(
+	ctx context.Context, targetID int64, sourceIDs []int64, opts MergeOptions, waitOpts *JobWaitOptions,
+) (Ticket, error) {
+	job, err := z.MergeTickets(ctx, targetID, sourceIDs, opts)
+	if err != nil {
+		return Ticket{}, err
+	}
+
+	if err := z.WaitForJob(ctx, &job, waitOpts); err != nil {
+		return Ticket{}, err
+	}
+	return z.GetTicket(ctx, targetID)
+}
+
+func validateMerge(targetID int64, sourceIDs []int64) error {
+	errs := []error{requireID("target_id", targetID)}
+	switch {
+	case len(sourceIDs) == 0:
+		errs = append(errs, errors.New("at least one source ticket is required"))
+	case len(sourceIDs) > maxMergeSources:
+		errs = append(errs, fmt.Errorf("at most %d source tickets can be merged at once, got %d",
+			maxMergeSources, len(sourceIDs)))
+	}
+	if slices.Contains(sourceIDs, targetID) {
+		errs = append(errs, fmt.Errorf("ticket %d cannot be merged into itself", targetID))
+	}
+	return errors.Join(errs...)
+}
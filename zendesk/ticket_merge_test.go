@@ -0,0 +1,72 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/JacobPotter/go-zendesk/testhelper"
+)
+
+func TestMergeTicketsAndWait(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/tickets/2/merge.json":
+			var body map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatalf("Failed to decode merge request: %s", err)
+			}
+			if ids, _ := json.Marshal(body["ids"]); string(ids) != "[3,4]" || body["target_comment"] != "Merged" ||
+				body["source_comment_is_public"] != false {
+				t.Fatalf("Unexpected merge request %v", body)
+			}
+			_, _ = w.Write([]byte(`{"job_status": {"id": "merge-job", "status": "queued"}}`))
+		case r.URL.Path == "/job_statuses/show_many.json":
+			_, _ = w.Write([]byte(`{"job_statuses": [{"id": "merge-job", "status": "completed"}]}`))
+		case r.URL.Path == "/tickets/2.json":
+			_, _ = w.Write(testhelper.ReadFixture(t, filepath.Join(http.MethodGet, "ticket.json")))
+		default:
+			t.Fatalf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	c := NewTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	ticket, err := c.MergeTicketsAndWait(ctx, 2, []int64{3, 4}, MergeOptions{TargetComment: "Merged"},
+		&JobWaitOptions{Interval: time.Millisecond})
+	if err != nil {
+		t.Fatalf("Failed to merge tickets: %s", err)
+	}
+	if ticket.ID != 2 {
+		t.Fatalf("Expected the refreshed target ticket, got %v", ticket)
+	}
+}
+
+func TestMergeTicketsValidation(t *testing.T) {
+	mockAPI := testhelper.NewMockAPI(t, http.MethodGet, "ticket.json")
+	c := NewTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	tests := map[string]struct {
+		target  int64
+		sources []int64
+		want    string
+	}{
+		"no sources": {target: 1, want: "at least one source"},
+		"too many":   {target: 1, sources: []int64{2, 3, 4, 5, 6, 7}, want: "at most 5"},
+		"self merge": {target: 1, sources: []int64{1}, want: "into itself"},
+		"no target":  {sources: []int64{2}, want: "target_id is required"},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := c.MergeTickets(ctx, tt.target, tt.sources, MergeOptions{})
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("Expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
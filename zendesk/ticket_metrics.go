@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/JacobPotter/go-zendesk/client"
-	"time"
 )
 
 // TimeDuration represents a time in business or calendar days
@@ -16,17 +15,17 @@ type TimeDuration struct {
 
 type TicketMetric struct {
 	AgentWaitTimeInMinutes       TimeDuration `json:"agent_wait_time_in_minutes"`
-	AssignedAt                   time.Time    `json:"assigned_at"`
+	AssignedAt                   *Timestamp   `json:"assigned_at,omitempty"`
 	AssigneeStations             int          `json:"assignee_stations"`
-	AssigneeUpdatedAt            time.Time    `json:"assignee_updated_at"`
-	CreatedAt                    time.Time    `json:"created_at"`
-	CustomStatusUpdatedAt        time.Time    `json:"custom_status_updated_at"`
+	AssigneeUpdatedAt            *Timestamp   `json:"assignee_updated_at,omitempty"`
+	CreatedAt                    *Timestamp   `json:"created_at,omitempty"`
+	CustomStatusUpdatedAt        *Timestamp   `json:"custom_status_updated_at,omitempty"`
 	FirstResolutionTimeInMinutes TimeDuration `json:"first_resolution_time_in_minutes"`
 	FullResolutionTimeInMinutes  TimeDuration `json:"full_resolution_time_in_minutes"`
 	GroupStations                int          `json:"group_stations"`
 	ID                           int          `json:"id"`
-	InitiallyAssignedAt          time.Time    `json:"initially_assigned_at"`
-	LatestCommentAddedAt         time.Time    `json:"latest_comment_added_at"`
+	InitiallyAssignedAt          *Timestamp   `json:"initially_assigned_at,omitempty"`
+	LatestCommentAddedAt         *Timestamp   `json:"latest_comment_added_at,omitempty"`
 	OnHoldTimeInMinutes          TimeDuration `json:"on_hold_time_in_minutes"`
 	Reopens                      int          `json:"reopens"`
 	Replies                      int          `json:"replies"`
@@ -34,14 +33,64 @@ type TicketMetric struct {
 	ReplyTimeInSeconds           struct {
 		Calendar int `json:"calendar"`
 	} `json:"reply_time_in_seconds"`
-	RequesterUpdatedAt         time.Time    `json:"requester_updated_at"`
+	RequesterUpdatedAt         *Timestamp   `json:"requester_updated_at,omitempty"`
 	RequesterWaitTimeInMinutes TimeDuration `json:"requester_wait_time_in_minutes"`
-	SolvedAt                   time.Time    `json:"solved_at"`
-	StatusUpdatedAt            time.Time    `json:"status_updated_at"`
+	SolvedAt                   *Timestamp   `json:"solved_at,omitempty"`
+	StatusUpdatedAt            *Timestamp   `json:"status_updated_at,omitempty"`
 	TicketID                   int          `json:"ticket_id"`
-	UpdatedAt                  time.Time    `json:"updated_at"`
+	UpdatedAt                  *Timestamp   `json:"updated_at,omitempty"`
 }
 
+// TicketMetricEvent is a change of one of the metrics of a ticket, such as the start of its reply time
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/ticket_metric_events/
+type TicketMetricEvent struct {
+	ID         int64 `json:"id"`
+	TicketID   int64 `json:"ticket_id"`
+	InstanceID int64 `json:"instance_id"`
+	// Metric can take "agent_work_time", "pausable_update_time", "periodic_update_time",
+	// "reply_time", "requester_wait_time" or "resolution_time"
+	Metric string `json:"metric"`
+	// Type can take "activate", "pause", "fulfill", "apply_sla", "apply_group_sla", "breach",
+	// "update_status" or "measure"
+	Type string     `json:"type"`
+	Time *Timestamp `json:"time,omitempty"`
+
+	// SLA is the SLA applied by an "apply_sla" event
+	SLA *TicketMetricEventSLA `json:"sla,omitempty"`
+	// GroupSLA is the group SLA applied by an "apply_group_sla" event
+	GroupSLA *TicketMetricEventSLA `json:"group_sla,omitempty"`
+	// Status is the time measured so far, in minutes, by an "update_status" event
+	Status *TimeDuration `json:"status,omitempty"`
+	// Deleted is set on a "breach" event which no longer applies, e.g. because the SLA was removed
+	Deleted bool `json:"deleted,omitempty"`
+}
+
+// TicketMetricEventSLA is the SLA target applied to a metric of a ticket
+type TicketMetricEventSLA struct {
+	// Target is in minutes
+	Target          int  `json:"target"`
+	TargetInSeconds int  `json:"target_in_seconds,omitempty"`
+	BusinessHours   bool `json:"business_hours"`
+	Policy          struct {
+		ID          int64  `json:"id"`
+		Title       string `json:"title"`
+		Description string `json:"description,omitempty"`
+	} `json:"policy"`
+}
+
+// Types of ticket metric events
+const (
+	TicketMetricEventActivate      = "activate"
+	TicketMetricEventPause         = "pause"
+	TicketMetricEventFulfill       = "fulfill"
+	TicketMetricEventApplySLA      = "apply_sla"
+	TicketMetricEventApplyGroupSLA = "apply_group_sla"
+	TicketMetricEventBreach        = "breach"
+	TicketMetricEventUpdateStatus  = "update_status"
+	TicketMetricEventMeasure       = "measure"
+)
+
 type TicketMetricListOptions struct {
 	PageOptions
 
@@ -0,0 +1,82 @@
+package zendesk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/JacobPotter/go-zendesk/client"
+)
+
+// maxSafeUpdateAttempts is the number of times UpdateTicketSafely applies its mutator before giving up
+const maxSafeUpdateAttempts = 5
+
+// TicketConflictError is returned by UpdateTicketSafely when the ticket kept being updated by someone else
+type TicketConflictError struct {
+	TicketID int64
+	Attempts int
+
+	// Err is the 409 Conflict of the last attempt
+	Err error
+}
+
+func (e *TicketConflictError) Error() string {
+	return fmt.Sprintf("ticket %d was updated concurrently, gave up after %d attempts: %s", e.TicketID, e.Attempts, e.Err)
+}
+
+func (e *TicketConflictError) Unwrap() error {
+	return e.Err
+}
+
+// UpdateTicketSafely fetches the ticket, applies mutate to it and sends the fields mutate changed, as
+// computed by DiffTickets, as a safe update which Zendesk rejects if the ticket changed since it was
+// fetched. Fields DiffTickets does not compare are not sent. On a conflict the ticket is fetched and
+// mutated again, up to 5 times before a *TicketConflictError is returned. mutate may be called several
+// times and must start from the ticket it is given; an error from mutate aborts the update. The fetched
+// ticket is returned without any request if mutate changes nothing.
+//
+// ref: https://developer.zendesk.com/documentation/ticketing/managing-tickets/creating-and-updating-tickets/#protecting-against-ticket-update-collisions
+func (z *Client) UpdateTicketSafely(ctx context.Context, ticketID int64, mutate func(*Ticket) error) (Ticket, error) {
+	var conflict error
+	for attempt := 0; attempt < maxSafeUpdateAttempts; attempt++ {
+		before, err := z.GetTicket(ctx, ticketID)
+		if err != nil {
+			return Ticket{}, err
+		}
+		stamp := before.UpdatedAt.AsTime()
+		if stamp.IsZero() {
+			return Ticket{}, fmt.Errorf("ticket %d has no updated_at to protect the update with", ticketID)
+		}
+
+		// mutate gets its own copy of the lists, custom field values included, so that the changes it
+		// makes in place are diffed
+		after := before
+		after.Tags = slices.Clone(before.Tags)
+		after.FollowerIDs = slices.Clone(before.FollowerIDs)
+		after.EmailCCIDs = slices.Clone(before.EmailCCIDs)
+		after.CustomFields = make([]CustomField, len(before.CustomFields))
+		for i, cf := range before.CustomFields {
+			after.CustomFields[i] = cf.clone()
+		}
+		if err := mutate(&after); err != nil {
+			return Ticket{}, err
+		}
+
+		update := DiffTickets(before, after)
+		if update.IsEmpty() {
+			return before, nil
+		}
+
+		updated, err := z.UpdateTicketWith(ctx, ticketID, update.SafeUpdate(stamp))
+		var apiErr client.Error
+		if errors.As(err, &apiErr) && apiErr.Status() == http.StatusConflict {
+			conflict = err
+			continue
+		}
+		return updated, err
+	}
+
+	return Ticket{}, &TicketConflictError{TicketID: ticketID, Attempts: maxSafeUpdateAttempts, Err: conflict}
+}
@@ -0,0 +1,167 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/JacobPotter/go-zendesk/testhelper"
+)
+
+func TestUpdateTicketSafelyRetriesConflicts(t *testing.T) {
+	var puts []map[string]interface{}
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			_, err := w.Write(testhelper.ReadFixture(t, filepath.Join(http.MethodGet, "ticket.json")))
+			if err != nil {
+				t.Fatalf("Failed to write fixture: %s", err)
+			}
+		case http.MethodPut:
+			var data struct {
+				Ticket map[string]interface{} `json:"ticket"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+				t.Fatalf("Failed to decode update: %s", err)
+			}
+			puts = append(puts, data.Ticket)
+			if len(puts) == 1 {
+				w.WriteHeader(http.StatusConflict)
+				return
+			}
+			_, err := w.Write(testhelper.ReadFixture(t, filepath.Join(http.MethodPut, "ticket.json")))
+			if err != nil {
+				t.Fatalf("Failed to write fixture: %s", err)
+			}
+		}
+	}))
+	c := NewTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	calls := 0
+	_, err := c.UpdateTicketSafely(ctx, 2, func(ticket *Ticket) error {
+		calls++
+		ticket.Tags = append(ticket.Tags, "escalated")
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to update ticket: %s", err)
+	}
+	if calls != 2 || len(puts) != 2 {
+		t.Fatalf("Expected the conflict to be retried, got %d mutations and %d updates", calls, len(puts))
+	}
+
+	// only the changed fields are sent, so that concurrent changes to the other fields are kept
+	expected := map[string]interface{}{
+		"additional_tags": []interface{}{"escalated"},
+		"safe_update":     true,
+		"updated_stamp":   "2019-06-05T01:13:24Z",
+	}
+	if !reflect.DeepEqual(puts[1], expected) {
+		t.Fatalf("Expected update %v, got %v", expected, puts[1])
+	}
+}
+
+func TestUpdateTicketSafelyMultiselectChangedInPlace(t *testing.T) {
+	var put map[string]interface{}
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			var data map[string]map[string]interface{}
+			if err := json.Unmarshal(testhelper.ReadFixture(t, filepath.Join(http.MethodGet, "ticket.json")), &data); err != nil {
+				t.Fatalf("Failed to decode fixture: %s", err)
+			}
+			data["ticket"]["custom_fields"] = []interface{}{
+				map[string]interface{}{"id": 7, "value": []string{"red", "blue"}},
+			}
+			if err := json.NewEncoder(w).Encode(data); err != nil {
+				t.Fatalf("Failed to write ticket: %s", err)
+			}
+		case http.MethodPut:
+			var data struct {
+				Ticket map[string]interface{} `json:"ticket"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+				t.Fatalf("Failed to decode update: %s", err)
+			}
+			put = data.Ticket
+			_, err := w.Write(testhelper.ReadFixture(t, filepath.Join(http.MethodPut, "ticket.json")))
+			if err != nil {
+				t.Fatalf("Failed to write fixture: %s", err)
+			}
+		}
+	}))
+	c := NewTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := c.UpdateTicketSafely(ctx, 2, func(ticket *Ticket) error {
+		ticket.CustomFields[0].Value.([]string)[0] = "green"
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to update ticket: %s", err)
+	}
+
+	expected := []interface{}{map[string]interface{}{"id": float64(7), "value": []interface{}{"green", "blue"}}}
+	if put == nil || !reflect.DeepEqual(put["custom_fields"], expected) {
+		t.Fatalf("Expected the changed multiselect to be sent, got %v", put)
+	}
+}
+
+func TestUpdateTicketSafelyWithoutChanges(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Fatalf("Unexpected %s request", r.Method)
+		}
+		_, err := w.Write(testhelper.ReadFixture(t, filepath.Join(http.MethodGet, "ticket.json")))
+		if err != nil {
+			t.Fatalf("Failed to write fixture: %s", err)
+		}
+	}))
+	c := NewTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	ticket, err := c.UpdateTicketSafely(ctx, 2, func(*Ticket) error { return nil })
+	if err != nil || ticket.ID != 2 {
+		t.Fatalf("Expected the fetched ticket, got %v, %v", ticket, err)
+	}
+}
+
+func TestUpdateTicketSafelyGivesUp(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+		_, err := w.Write(testhelper.ReadFixture(t, filepath.Join(http.MethodGet, "ticket.json")))
+		if err != nil {
+			t.Fatalf("Failed to write fixture: %s", err)
+		}
+	}))
+	c := NewTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := c.UpdateTicketSafely(ctx, 2, func(ticket *Ticket) error {
+		ticket.Status = "closed"
+		return nil
+	})
+	var conflict *TicketConflictError
+	if !errors.As(err, &conflict) || conflict.Attempts != maxSafeUpdateAttempts {
+		t.Fatalf("Expected a conflict error, got %v", err)
+	}
+}
+
+func TestUpdateTicketSafelyMutatorError(t *testing.T) {
+	mockAPI := testhelper.NewMockAPI(t, http.MethodGet, "ticket.json")
+	c := NewTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	abort := errors.New("abort")
+	if _, err := c.UpdateTicketSafely(ctx, 2, func(*Ticket) error { return abort }); !errors.Is(err, abort) {
+		t.Fatalf("Expected the mutator error, got %v", err)
+	}
+}
@@ -3,10 +3,13 @@ package zendesk
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"github.com/JacobPotter/go-zendesk/client"
 	"github.com/JacobPotter/go-zendesk/testhelper"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"path/filepath"
 	"reflect"
 	"sort"
 	"testing"
@@ -261,22 +264,28 @@ func TestGetTicketCanceledContext(t *testing.T) {
 	}
 }
 
-// Test the CustomField unmarshalling fails on an invalid value.
-// In this case a float64 as CustomField.Value should cause an error.
+// Test the CustomField unmarshalling fails on an invalid value or a missing id.
 func TestGetTicketWithInvalidCustomField(t *testing.T) {
-	// Test with a number value.
-	invalidCustomFieldJson := `{ "id": 360005657120, "value": 123.456 }`
+	// Test with an array of numbers.
+	invalidCustomFieldJson := `{ "id": 360005657120, "value": [123, 456] }`
 	var customField CustomField
 	err := json.Unmarshal([]byte(invalidCustomFieldJson), &customField)
 	if err == nil {
-		t.Fatalf("Expected an error when parsing a custom field of type number.")
+		t.Fatalf("Expected an error when parsing a custom field of type [number, ...].")
 	}
 
-	// Test with an array of numbers.
-	invalidCustomFieldJson = `{ "id": 360005657120, "value": [123, 456] }`
+	// Test with an object.
+	invalidCustomFieldJson = `{ "id": 360005657120, "value": {"id": 1} }`
 	err = json.Unmarshal([]byte(invalidCustomFieldJson), &customField)
 	if err == nil {
-		t.Fatalf("Expected an error when parsing a custom field of type [number, ...].")
+		t.Fatalf("Expected an error when parsing a custom field of type object.")
+	}
+
+	// Test without an id.
+	invalidCustomFieldJson = `{ "value": "text" }`
+	err = json.Unmarshal([]byte(invalidCustomFieldJson), &customField)
+	if err == nil {
+		t.Fatalf("Expected an error when parsing a custom field without id.")
 	}
 }
 
@@ -427,3 +436,132 @@ func TestTicketMarshalling(t *testing.T) {
 	}
 
 }
+
+func TestIterateTickets(t *testing.T) {
+	var query url.Values
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		_, err := w.Write(testhelper.ReadFixture(t, filepath.Join(http.MethodGet, "tickets.json")))
+		if err != nil {
+			t.Fatalf("Failed to write fixture: %s", err)
+		}
+	}))
+	c := NewTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	it := c.IterateTickets(ctx, &TicketIteratorOptions{
+		IteratorOptions: IteratorOptions{PageSize: 10},
+		Sort:            "-updated_at",
+	})
+
+	ticketCount := 0
+	for it.HasMore() {
+		tickets, err := it.GetNext()
+		if err != nil {
+			t.Fatalf("Failed to get tickets: %s", err)
+		}
+		ticketCount += len(tickets)
+	}
+
+	expectedLength := 2
+	if ticketCount != expectedLength {
+		t.Fatalf("Returned tickets does not have the expected length %d. Tickets length is %d", expectedLength, ticketCount)
+	}
+	if query.Has("active") {
+		t.Fatalf("Expected no active parameter to be sent, got %s", query.Encode())
+	}
+	if query.Get("sort") != "-updated_at" || query.Get("page[size]") != "10" {
+		t.Fatalf("Unexpected query %s", query.Encode())
+	}
+}
+
+func TestIterateTicketsInvalidSort(t *testing.T) {
+	mockAPI := testhelper.NewMockAPI(t, http.MethodGet, "tickets.json")
+	c := NewTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	it := c.IterateTickets(ctx, &TicketIteratorOptions{Sort: "priority"})
+	_, err := it.GetNext()
+	if err == nil {
+		t.Fatal("Expected an error for an unsupported sort value")
+	}
+	if it.HasMore() {
+		t.Fatal("Expected iterator to stop after a validation error")
+	}
+}
+
+func TestIterateTicketsSideloads(t *testing.T) {
+	var query url.Values
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		_, err := w.Write(testhelper.ReadFixture(t, filepath.Join(http.MethodGet, "tickets_sideloads.json")))
+		if err != nil {
+			t.Fatalf("Failed to write fixture: %s", err)
+		}
+	}))
+	c := NewTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	it := c.IterateTickets(ctx, &TicketIteratorOptions{
+		Include: "users,groups,organizations,brands,metric_sets,comment_count,dates",
+	})
+	tickets, err := it.GetNext()
+	if err != nil {
+		t.Fatalf("Failed to get tickets: %s", err)
+	}
+	if len(tickets) != 1 || it.HasMore() {
+		t.Fatalf("Unexpected tickets %v", tickets)
+	}
+	if query.Get("include") != "users,groups,organizations,brands,metric_sets,comment_count,dates" {
+		t.Fatalf("Unexpected query %s", query.Encode())
+	}
+
+	sideloads := it.Sideloads()
+	requester, ok := sideloads.User(tickets[0].RequesterID)
+	if !ok || requester.Name != "Jane Doe" {
+		t.Fatalf("Requester was not side-loaded: %v", sideloads.Users)
+	}
+	groupID, _ := tickets[0].GroupID.Int64()
+	if _, ok := sideloads.Group(groupID); !ok {
+		t.Fatalf("Group was not side-loaded: %v", sideloads.Groups)
+	}
+	if _, ok := sideloads.Organization(tickets[0].OrganizationID); !ok {
+		t.Fatalf("Organization was not side-loaded: %v", sideloads.Organizations)
+	}
+	if len(sideloads.Brands) != 1 || len(sideloads.MetricSets) != 1 || sideloads.MetricSets[0].Replies != 2 {
+		t.Fatalf("Unexpected brands %v and metric sets %v", sideloads.Brands, sideloads.MetricSets)
+	}
+	if sideloads.CommentCounts[35436] != 3 {
+		t.Fatalf("Unexpected comment counts %v", sideloads.CommentCounts)
+	}
+	dates := sideloads.Dates[35436]
+	if dates.StatusUpdatedAt == nil || dates.SolvedAt != nil {
+		t.Fatalf("Unexpected dates %v", dates)
+	}
+}
+
+func TestIterateTicketsInvalidInclude(t *testing.T) {
+	mockAPI := testhelper.NewMockAPI(t, http.MethodGet, "tickets_sideloads.json")
+	c := NewTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := c.IterateTickets(ctx, &TicketIteratorOptions{Include: "users,slas"}).GetNext()
+	var invalid *InvalidOptionError
+	if !errors.As(err, &invalid) || invalid.Value != "slas" {
+		t.Fatalf("Expected an invalid include error, got %v", err)
+	}
+}
+
+func TestGetTicketWithSideloads(t *testing.T) {
+	mockAPI := testhelper.NewMockAPI(t, http.MethodGet, "ticket_sideloads.json")
+	c := NewTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	ticket, sideloads, err := c.GetTicketWithSideloads(ctx, 35436, "users,comment_count")
+	if err != nil {
+		t.Fatalf("Failed to get ticket: %s", err)
+	}
+	if ticket.ID != 35436 || len(sideloads.Users) != 1 || sideloads.CommentCounts[35436] != 3 {
+		t.Fatalf("Unexpected ticket %v and side-loads %v", ticket, sideloads)
+	}
+}
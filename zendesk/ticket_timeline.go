@@ -0,0 +1,208 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TicketTimeline is the history of the fields of a ticket, replayed from the Create and Change events
+// of its audits. Custom fields are named by their id, e.g. "360001234567".
+type TicketTimeline struct {
+	TicketID int64
+
+	// Changes are the changes of every field, in chronological order. The values set when the ticket
+	// was created come first.
+	Changes []FieldChange
+}
+
+// FieldChange is the change of a field of a ticket recorded by an audit
+type FieldChange struct {
+	Field         string
+	Value         interface{}
+	PreviousValue interface{}
+
+	// Created is true for the value set when the ticket was created
+	Created bool
+
+	At       time.Time
+	AuditID  int64
+	AuthorID int64
+
+	// Channel is the channel of the audit, e.g. "web", "api" or "rule"
+	Channel string
+
+	// Rule is the trigger or automation which made the change, if any
+	Rule *RuleReference
+}
+
+// RuleReference identifies the business rule which made a change
+type RuleReference struct {
+	// Type is "trigger" or "automation"
+	Type  string
+	ID    int64
+	Title string
+}
+
+// TicketState is the value of the fields of a ticket at a point in time, by field name
+type TicketState map[string]interface{}
+
+// String returns the value of field as a string. Lists such as tags are joined with spaces.
+func (s TicketState) String(field string) string {
+	return valueString(s[field])
+}
+
+// GetTicketTimeline fetches all the audits of a ticket and replays them into its timeline
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/ticket_audits/#list-audits-for-a-ticket
+func (z *Client) GetTicketTimeline(ctx context.Context, ticketID int64) (*TicketTimeline, error) {
+	audits, err := collect(z.IterateTicketAudits(ctx, &TicketAuditIteratorOptions{TicketID: ticketID}))
+	if err != nil {
+		return nil, err
+	}
+	return NewTicketTimeline(ticketID, audits), nil
+}
+
+// NewTicketTimeline replays the audits of a ticket, in any order, into its timeline
+func NewTicketTimeline(ticketID int64, audits []TicketAudit) *TicketTimeline {
+	sorted := make([]TicketAudit, len(audits))
+	copy(sorted, audits)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].CreatedAt.AsTime().Before(sorted[j].CreatedAt.AsTime())
+	})
+
+	timeline := &TicketTimeline{TicketID: ticketID}
+	for _, audit := range sorted {
+		for _, event := range audit.Events {
+			change := FieldChange{
+				At:       audit.CreatedAt.AsTime(),
+				AuditID:  audit.ID,
+				AuthorID: audit.AuthorID,
+				Channel:  audit.Via.Channel,
+				Rule:     auditRule(audit.Via),
+			}
+
+			switch e := event.(type) {
+			case *CreateEvent:
+				change.Field, change.Value, change.Created = e.FieldName, e.Value, true
+			case *ChangeEvent:
+				change.Field, change.Value, change.PreviousValue = e.FieldName, e.Value, e.PreviousValue
+				if rule := viaRule(e.Via); rule != nil {
+					change.Rule = rule
+				}
+			default:
+				continue
+			}
+			timeline.Changes = append(timeline.Changes, change)
+		}
+	}
+	return timeline
+}
+
+// StateAt returns the value of the fields of the ticket at t. It is empty before the ticket was created.
+func (tl *TicketTimeline) StateAt(t time.Time) TicketState {
+	state := TicketState{}
+	for _, change := range tl.Changes {
+		if change.At.After(t) {
+			break
+		}
+		state[change.Field] = change.Value
+	}
+	return state
+}
+
+// FieldHistory returns the changes of field, in chronological order
+func (tl *TicketTimeline) FieldHistory(field string) []FieldChange {
+	var history []FieldChange
+	for _, change := range tl.Changes {
+		if change.Field == field {
+			history = append(history, change)
+		}
+	}
+	return history
+}
+
+// TimeInValue returns how long field kept each of its values until the given time, by value as
+// returned by TicketState.String. Pass time.Now() for a ticket which is not closed.
+func (tl *TicketTimeline) TimeInValue(field string, until time.Time) map[string]time.Duration {
+	durations := map[string]time.Duration{}
+	history := tl.FieldHistory(field)
+	for i, change := range history {
+		end := until
+		if i+1 < len(history) && history[i+1].At.Before(until) {
+			end = history[i+1].At
+		}
+		if end.After(change.At) {
+			durations[valueString(change.Value)] += end.Sub(change.At)
+		}
+	}
+	return durations
+}
+
+// TimeInStatus returns how long the ticket spent in each status until the given time
+func (tl *TicketTimeline) TimeInStatus(until time.Time) map[string]time.Duration {
+	return tl.TimeInValue("status", until)
+}
+
+// TimeInGroup returns how long the ticket was assigned to each group until the given time, by group id.
+// The time without a group is under "".
+func (tl *TicketTimeline) TimeInGroup(until time.Time) map[string]time.Duration {
+	return tl.TimeInValue("group_id", until)
+}
+
+// valueString returns an audit value as a string. Numbers are formatted without exponent, so that an id
+// given as a number, e.g. 360001234567, reads the same as the id given as a string.
+func valueString(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case []interface{}:
+		values := make([]string, len(v))
+		for i, item := range v {
+			values[i] = valueString(item)
+		}
+		return strings.Join(values, " ")
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// viaRule returns the business rule of the via of an event
+func viaRule(via *Via) *RuleReference {
+	if via == nil {
+		return nil
+	}
+	return newRuleReference(via.Source.Rel, via.Source.From)
+}
+
+// auditRule returns the business rule of the via of an audit
+func auditRule(via TicketAuditVia) *RuleReference {
+	from, _ := via.Source.From.(map[string]interface{})
+	return newRuleReference(via.Source.Rel, from)
+}
+
+func newRuleReference(rel string, from map[string]interface{}) *RuleReference {
+	if (rel != "trigger" && rel != "automation") || from == nil {
+		return nil
+	}
+
+	rule := &RuleReference{Type: rel}
+	rule.Title, _ = from["title"].(string)
+	switch id := from["id"].(type) {
+	case float64:
+		rule.ID = int64(id)
+	case json.Number:
+		rule.ID, _ = id.Int64()
+	}
+	return rule
+}
@@ -0,0 +1,143 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/JacobPotter/go-zendesk/testhelper"
+)
+
+func TestGetTicketTimeline(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/tickets/666/audits.json" || r.URL.Query().Has("active") {
+			t.Errorf("Unexpected request %s", r.URL)
+		}
+		_, _ = w.Write(testhelper.ReadFixture(t, filepath.Join(http.MethodGet, "ticket_audits.json")))
+	}))
+	c := NewTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	timeline, err := c.GetTicketTimeline(ctx, 666)
+	if err != nil {
+		t.Fatalf("Failed to get ticket timeline: %s", err)
+	}
+
+	history := timeline.FieldHistory("status")
+	if len(history) != 1 {
+		t.Fatalf("Expected 1 status change, got %d", len(history))
+	}
+	change := history[0]
+	if change.Value != "open" || change.PreviousValue != "new" || change.AuthorID != 5246746 || change.Channel != "web" {
+		t.Fatalf("Unexpected status change: %v", change)
+	}
+	if change.Rule == nil || *change.Rule != (RuleReference{Type: "trigger", ID: 35079792, Title: "Assign to first responder"}) {
+		t.Fatalf("Unexpected rule of the change: %v", change.Rule)
+	}
+}
+
+func TestTicketTimeline(t *testing.T) {
+	// the audits are given out of order
+	data := `[
+	{"id":3,"created_at":"2024-03-05T13:00:00Z","author_id":10,"via":{"channel":"web"},"events":[
+		{"id":31,"type":"Change","field_name":"status","value":"solved","previous_value":"open"}]},
+	{"id":1,"created_at":"2024-03-05T10:00:00Z","author_id":20,"via":{"channel":"email"},"events":[
+		{"id":11,"type":"Comment","body":"Help","public":true},
+		{"id":12,"type":"Create","field_name":"status","value":"new"},
+		{"id":13,"type":"Create","field_name":"group_id","value":"1"},
+		{"id":14,"type":"Create","field_name":"tags","value":["vip","billing"]}]},
+	{"id":2,"created_at":"2024-03-05T11:00:00Z","author_id":-1,
+		"via":{"channel":"rule","source":{"from":{"id":7,"title":"Escalate"},"rel":"automation"}},"events":[
+		{"id":21,"type":"Change","field_name":"status","value":"open","previous_value":"new"},
+		{"id":22,"type":"Change","field_name":"group_id","value":"2","previous_value":"1"}]}
+	]`
+
+	var audits []TicketAudit
+	if err := json.Unmarshal([]byte(data), &audits); err != nil {
+		t.Fatalf("Failed to unmarshal audits: %s", err)
+	}
+	timeline := NewTicketTimeline(1, audits)
+
+	at := func(hour, minute int) time.Time {
+		return time.Date(2024, time.March, 5, hour, minute, 0, 0, time.UTC)
+	}
+
+	if state := timeline.StateAt(at(9, 0)); len(state) != 0 {
+		t.Fatalf("Expected no state before the creation, got %v", state)
+	}
+	state := timeline.StateAt(at(10, 30))
+	if state.String("status") != "new" || state.String("group_id") != "1" || state.String("tags") != "vip billing" {
+		t.Fatalf("Unexpected state at 10:30: %v", state)
+	}
+	state = timeline.StateAt(at(11, 0))
+	if state.String("status") != "open" || state.String("group_id") != "2" {
+		t.Fatalf("Unexpected state at 11:00: %v", state)
+	}
+
+	history := timeline.FieldHistory("group_id")
+	if len(history) != 2 || !history[0].Created || history[1].Created {
+		t.Fatalf("Unexpected group history: %v", history)
+	}
+	if rule := history[1].Rule; rule == nil || rule.Type != "automation" || rule.ID != 7 || rule.Title != "Escalate" {
+		t.Fatalf("Unexpected rule of the group change: %v", rule)
+	}
+
+	inStatus := timeline.TimeInStatus(at(14, 0))
+	expected := map[string]time.Duration{"new": time.Hour, "open": 2 * time.Hour, "solved": time.Hour}
+	if len(inStatus) != len(expected) {
+		t.Fatalf("Unexpected time in status: %v", inStatus)
+	}
+	for status, d := range expected {
+		if inStatus[status] != d {
+			t.Errorf("Expected %s in %s, got %s", d, status, inStatus[status])
+		}
+	}
+
+	inGroup := timeline.TimeInGroup(at(10, 30))
+	if len(inGroup) != 1 || inGroup["1"] != 30*time.Minute {
+		t.Fatalf("Unexpected time in group: %v", inGroup)
+	}
+}
+
+func TestTicketTimelineNumericValues(t *testing.T) {
+	// group ids may be given as numbers or as strings
+	data := `[
+	{"id":1,"created_at":"2024-03-05T10:00:00Z","author_id":20,"events":[
+		{"id":11,"type":"Create","field_name":"group_id","value":360001234567}]},
+	{"id":2,"created_at":"2024-03-05T11:00:00Z","author_id":20,"events":[
+		{"id":21,"type":"Change","field_name":"group_id","value":"360007654321","previous_value":360001234567}]},
+	{"id":3,"created_at":"2024-03-05T12:00:00Z","author_id":20,"events":[
+		{"id":31,"type":"Change","field_name":"group_id","value":360001234567,"previous_value":"360007654321"}]}
+	]`
+
+	var audits []TicketAudit
+	if err := json.Unmarshal([]byte(data), &audits); err != nil {
+		t.Fatalf("Failed to unmarshal audits: %s", err)
+	}
+	timeline := NewTicketTimeline(1, audits)
+
+	until := time.Date(2024, time.March, 5, 13, 0, 0, 0, time.UTC)
+	inGroup := timeline.TimeInGroup(until)
+	expected := map[string]time.Duration{"360001234567": 2 * time.Hour, "360007654321": time.Hour}
+	if len(inGroup) != len(expected) {
+		t.Fatalf("Unexpected time in group: %v", inGroup)
+	}
+	for group, d := range expected {
+		if inGroup[group] != d {
+			t.Errorf("Expected %s in group %s, got %s", d, group, inGroup[group])
+		}
+	}
+	if state := timeline.StateAt(until); state.String("group_id") != "360001234567" {
+		t.Fatalf("Unexpected group %q", state.String("group_id"))
+	}
+
+	if s := valueString(json.Number("42")); s != "42" {
+		t.Fatalf("Unexpected json.Number value %q", s)
+	}
+	if s := valueString(1.5); s != "1.5" {
+		t.Fatalf("Unexpected decimal value %q", s)
+	}
+}
@@ -0,0 +1,476 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// TicketUpdate is a ticket update which only sends the fields it sets, unlike UpdateTicket which sends
+// every field set on a Ticket. Tags, followers and email CCs are added and removed rather than replaced,
+// so that updates made concurrently by automations or other agents are kept.
+//
+// Build it with the setters, which can be chained, or with DiffTickets:
+//
+//	update := zendesk.NewTicketUpdate().SetStatus("pending").AddTags("waiting").RemoveTags("new")
+//	ticket, err := client.UpdateTicketWith(ctx, id, update)
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/tickets/#update-ticket
+type TicketUpdate struct {
+	fields       map[string]interface{}
+	customFields []CustomField
+	addTags      []string
+	removeTags   []string
+	followers    []ticketUserAction
+	emailCCs     []ticketUserAction
+	comment      *TicketComment
+	err          error
+}
+
+// ticketUserAction adds (put) or removes (delete) a follower or email CC
+type ticketUserAction struct {
+	UserID    int64  `json:"user_id,omitempty"`
+	UserEmail string `json:"user_email,omitempty"`
+	UserName  string `json:"user_name,omitempty"`
+	Action    string `json:"action"`
+}
+
+// NewTicketUpdate returns an empty TicketUpdate
+func NewTicketUpdate() *TicketUpdate {
+	return &TicketUpdate{fields: map[string]interface{}{}}
+}
+
+// DiffTickets returns the update turning before into after. Fields which changed to their zero value are
+// cleared, except the status which cannot be. Tags, followers and email CCs are added and removed
+// rather than replaced, and after.Comment is added if set.
+func DiffTickets(before, after Ticket) *TicketUpdate {
+	u := NewTicketUpdate()
+
+	diffField(u, "subject", before.Subject, after.Subject)
+	diffField(u, "type", before.Type, after.Type)
+	diffField(u, "priority", before.Priority, after.Priority)
+	diffField(u, "external_id", before.ExternalID, after.ExternalID)
+	diffField(u, "custom_status_id", before.CustomStatusID, after.CustomStatusID)
+	diffField(u, "requester_id", before.RequesterID, after.RequesterID)
+	diffField(u, "assignee_id", before.AssigneeID, after.AssigneeID)
+	diffField(u, "organization_id", before.OrganizationID, after.OrganizationID)
+	diffField(u, "group_id", before.GroupID, after.GroupID)
+	diffField(u, "problem_id", before.ProblemID, after.ProblemID)
+	diffField(u, "ticket_form_id", before.TicketFormID, after.TicketFormID)
+	diffField(u, "brand_id", before.BrandID, after.BrandID)
+	if after.Status != "" && after.Status != before.Status {
+		u.SetStatus(after.Status)
+	}
+	if !before.DueAt.AsTime().Equal(after.DueAt.AsTime()) {
+		if after.DueAt.AsTime().IsZero() {
+			u.ClearDueAt()
+		} else {
+			u.SetDueAt(after.DueAt.AsTime())
+		}
+	}
+
+	addedTags, removedTags := diffSlices(before.Tags, after.Tags)
+	u.AddTags(addedTags...).RemoveTags(removedTags...)
+	addedFollowers, removedFollowers := diffSlices(before.FollowerIDs, after.FollowerIDs)
+	u.AddFollowers(addedFollowers...).RemoveFollowers(removedFollowers...)
+	addedCCs, removedCCs := diffSlices(before.EmailCCIDs, after.EmailCCIDs)
+	u.AddEmailCCs(addedCCs...).RemoveEmailCCs(removedCCs...)
+
+	for _, cf := range after.CustomFields {
+		if previous, ok := before.CustomField(cf.ID); !ok || !equalCustomFields(previous, cf) {
+			u.setCustomField(cf)
+		}
+	}
+
+	if after.Comment != nil {
+		u.AddComment(*after.Comment)
+	}
+	return u
+}
+
+// TicketPatch holds ticket fields which can be set to a value or cleared with Null, for use with
+// TicketUpdate.Patch
+type TicketPatch struct {
+	Subject        Nullable[string]    `json:"subject"`
+	Status         Nullable[string]    `json:"status"`
+	CustomStatusID Nullable[int64]     `json:"custom_status_id"`
+	Priority       Nullable[string]    `json:"priority"`
+	Type           Nullable[string]    `json:"type"`
+	ExternalID     Nullable[string]    `json:"external_id"`
+	RequesterID    Nullable[int64]     `json:"requester_id"`
+	AssigneeID     Nullable[int64]     `json:"assignee_id"`
+	GroupID        Nullable[int64]     `json:"group_id"`
+	OrganizationID Nullable[int64]     `json:"organization_id"`
+	ProblemID      Nullable[int64]     `json:"problem_id"`
+	TicketFormID   Nullable[int64]     `json:"ticket_form_id"`
+	BrandID        Nullable[int64]     `json:"brand_id"`
+	DueAt          Nullable[Timestamp] `json:"due_at"`
+}
+
+// Patch sets the fields set in patch, clearing those set to Null
+func (u *TicketUpdate) Patch(patch TicketPatch) *TicketUpdate {
+	fields, err := patchFields(patch)
+	if err != nil {
+		u.err = errors.Join(u.err, err)
+		return u
+	}
+	for field, value := range fields {
+		u.set(field, value)
+	}
+	return u
+}
+
+// SetSubject sets the subject
+func (u *TicketUpdate) SetSubject(subject string) *TicketUpdate {
+	return u.set("subject", subject)
+}
+
+// SetStatus sets the status
+func (u *TicketUpdate) SetStatus(status string) *TicketUpdate {
+	return u.set("status", status)
+}
+
+// SetCustomStatusID sets the custom status
+func (u *TicketUpdate) SetCustomStatusID(id int64) *TicketUpdate {
+	return u.set("custom_status_id", id)
+}
+
+// SetPriority sets the priority
+func (u *TicketUpdate) SetPriority(priority string) *TicketUpdate {
+	return u.set("priority", priority)
+}
+
+// SetType sets the type
+func (u *TicketUpdate) SetType(ticketType string) *TicketUpdate {
+	return u.set("type", ticketType)
+}
+
+// SetExternalID sets the external id
+func (u *TicketUpdate) SetExternalID(id string) *TicketUpdate {
+	return u.set("external_id", id)
+}
+
+// SetRequesterID sets the requester
+func (u *TicketUpdate) SetRequesterID(id int64) *TicketUpdate {
+	return u.set("requester_id", id)
+}
+
+// SetAssigneeID sets the assignee
+func (u *TicketUpdate) SetAssigneeID(id int64) *TicketUpdate {
+	return u.set("assignee_id", id)
+}
+
+// ClearAssignee unassigns the ticket
+func (u *TicketUpdate) ClearAssignee() *TicketUpdate {
+	return u.set("assignee_id", nil)
+}
+
+// SetGroupID sets the group
+func (u *TicketUpdate) SetGroupID(id int64) *TicketUpdate {
+	return u.set("group_id", id)
+}
+
+// ClearGroup removes the ticket from its group
+func (u *TicketUpdate) ClearGroup() *TicketUpdate {
+	return u.set("group_id", nil)
+}
+
+// SetOrganizationID sets the organization
+func (u *TicketUpdate) SetOrganizationID(id int64) *TicketUpdate {
+	return u.set("organization_id", id)
+}
+
+// SetProblemID links the ticket, which must be an incident, to a problem ticket
+func (u *TicketUpdate) SetProblemID(id int64) *TicketUpdate {
+	return u.set("problem_id", id)
+}
+
+// SetTicketFormID sets the ticket form
+func (u *TicketUpdate) SetTicketFormID(id int64) *TicketUpdate {
+	return u.set("ticket_form_id", id)
+}
+
+// SetBrandID sets the brand
+func (u *TicketUpdate) SetBrandID(id int64) *TicketUpdate {
+	return u.set("brand_id", id)
+}
+
+// SetDueAt sets the due date of a task
+func (u *TicketUpdate) SetDueAt(dueAt time.Time) *TicketUpdate {
+	return u.set("due_at", dueAt)
+}
+
+// ClearDueAt removes the due date of a task
+func (u *TicketUpdate) ClearDueAt() *TicketUpdate {
+	return u.set("due_at", nil)
+}
+
+// SetTags replaces all the tags. Prefer AddTags and RemoveTags, which keep concurrent tag changes.
+func (u *TicketUpdate) SetTags(tags ...string) *TicketUpdate {
+	u.addTags, u.removeTags = nil, nil
+	return u.set("tags", append([]string{}, tags...))
+}
+
+// AddTags adds tags, keeping the other tags of the ticket
+func (u *TicketUpdate) AddTags(tags ...string) *TicketUpdate {
+	u.addTags = appendUnique(u.addTags, tags...)
+	u.removeTags = slices.DeleteFunc(u.removeTags, func(t string) bool { return slices.Contains(tags, t) })
+	return u
+}
+
+// RemoveTags removes tags, keeping the other tags of the ticket
+func (u *TicketUpdate) RemoveTags(tags ...string) *TicketUpdate {
+	u.removeTags = appendUnique(u.removeTags, tags...)
+	u.addTags = slices.DeleteFunc(u.addTags, func(t string) bool { return slices.Contains(tags, t) })
+	return u
+}
+
+// AddFollowers adds followers, keeping the other followers of the ticket
+func (u *TicketUpdate) AddFollowers(userIDs ...int64) *TicketUpdate {
+	return u.AddFollowerUsers(ticketUsers(userIDs)...)
+}
+
+// RemoveFollowers removes followers, keeping the other followers of the ticket
+func (u *TicketUpdate) RemoveFollowers(userIDs ...int64) *TicketUpdate {
+	return u.RemoveFollowerUsers(ticketUsers(userIDs)...)
+}
+
+// AddFollowerUsers adds followers by id or email, keeping the other followers of the ticket.
+// Followers must be agents; unlike email CCs, no user is created for an unknown email.
+func (u *TicketUpdate) AddFollowerUsers(users ...TicketUser) *TicketUpdate {
+	u.followers = u.userActions(u.followers, "put", users)
+	return u
+}
+
+// RemoveFollowerUsers removes followers by id or email, keeping the other followers of the ticket
+func (u *TicketUpdate) RemoveFollowerUsers(users ...TicketUser) *TicketUpdate {
+	u.followers = u.userActions(u.followers, "delete", users)
+	return u
+}
+
+// AddEmailCCs adds email CCs, keeping the other CCs of the ticket
+func (u *TicketUpdate) AddEmailCCs(userIDs ...int64) *TicketUpdate {
+	return u.AddEmailCCUsers(ticketUsers(userIDs)...)
+}
+
+// AddEmailCCByEmail adds an email CC by email address, creating the user if needed
+func (u *TicketUpdate) AddEmailCCByEmail(email string) *TicketUpdate {
+	return u.AddEmailCCUsers(TicketUser{Email: email})
+}
+
+// RemoveEmailCCs removes email CCs, keeping the other CCs of the ticket
+func (u *TicketUpdate) RemoveEmailCCs(userIDs ...int64) *TicketUpdate {
+	return u.RemoveEmailCCUsers(ticketUsers(userIDs)...)
+}
+
+// AddEmailCCUsers adds email CCs by id or email, keeping the other CCs of the ticket. A user is created
+// for an unknown email, named after TicketUser.Name if set.
+func (u *TicketUpdate) AddEmailCCUsers(users ...TicketUser) *TicketUpdate {
+	u.emailCCs = u.userActions(u.emailCCs, "put", users)
+	return u
+}
+
+// RemoveEmailCCUsers removes email CCs by id or email, keeping the other CCs of the ticket
+func (u *TicketUpdate) RemoveEmailCCUsers(users ...TicketUser) *TicketUpdate {
+	u.emailCCs = u.userActions(u.emailCCs, "delete", users)
+	return u
+}
+
+// SetCustomField sets a custom field, see NewCustomField for the accepted values. A nil value clears it.
+// An invalid value is reported by Err and when the update is sent.
+func (u *TicketUpdate) SetCustomField(id int64, value interface{}) *TicketUpdate {
+	cf, err := NewCustomField(id, value)
+	if err != nil {
+		u.err = errors.Join(u.err, err)
+		return u
+	}
+	return u.setCustomField(cf)
+}
+
+// AddComment adds a comment with the update
+func (u *TicketUpdate) AddComment(comment TicketComment) *TicketUpdate {
+	u.comment = &comment
+	return u
+}
+
+// SafeUpdate makes the update fail with 409 Conflict if the ticket was updated after updatedAt
+//
+// ref: https://developer.zendesk.com/documentation/ticketing/managing-tickets/creating-and-updating-tickets/#protecting-against-ticket-update-collisions
+func (u *TicketUpdate) SafeUpdate(updatedAt time.Time) *TicketUpdate {
+	u.set("updated_stamp", updatedAt)
+	return u.set("safe_update", true)
+}
+
+// Err returns the errors of the setters, if any
+func (u *TicketUpdate) Err() error {
+	return u.err
+}
+
+// IsEmpty reports whether the update changes nothing
+func (u *TicketUpdate) IsEmpty() bool {
+	return len(u.fields) == 0 && len(u.customFields) == 0 && len(u.addTags) == 0 && len(u.removeTags) == 0 &&
+		len(u.followers) == 0 && len(u.emailCCs) == 0 && u.comment == nil
+}
+
+// MarshalJSON is marshaller for TicketUpdate, which only includes the fields which were set
+func (u *TicketUpdate) MarshalJSON() ([]byte, error) {
+	data := make(map[string]interface{}, len(u.fields)+7)
+	for k, v := range u.fields {
+		data[k] = v
+	}
+	if len(u.customFields) > 0 {
+		data["custom_fields"] = u.customFields
+	}
+	if len(u.addTags) > 0 {
+		data["additional_tags"] = u.addTags
+	}
+	if len(u.removeTags) > 0 {
+		data["remove_tags"] = u.removeTags
+	}
+	if len(u.followers) > 0 {
+		data["followers"] = u.followers
+	}
+	if len(u.emailCCs) > 0 {
+		data["email_ccs"] = u.emailCCs
+	}
+	if u.comment != nil {
+		data["comment"] = u.comment
+	}
+	return json.Marshal(data)
+}
+
+// UpdateTicketWith applies update to a ticket
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/tickets/#update-ticket
+func (z *Client) UpdateTicketWith(ctx context.Context, ticketID int64, update *TicketUpdate) (Ticket, error) {
+	var result struct {
+		Ticket Ticket `json:"ticket"`
+	}
+
+	if err := update.Err(); err != nil {
+		return Ticket{}, err
+	}
+
+	data := struct {
+		Ticket *TicketUpdate `json:"ticket"`
+	}{update}
+
+	body, err := z.Put(ctx, fmt.Sprintf("/tickets/%d.json", ticketID), data)
+	if err != nil {
+		return Ticket{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Ticket{}, err
+	}
+	return result.Ticket, nil
+}
+
+// UpdateManyTicketsWith queues the same update of every ticket in ticketIDs, 100 tickets per job
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/tickets/#update-many-tickets
+func (z *Client) UpdateManyTicketsWith(ctx context.Context, ticketIDs []int64, update *TicketUpdate) ([]BulkJob, error) {
+	if err := update.Err(); err != nil {
+		return nil, err
+	}
+
+	return bulk(ticketIDs, ticketIDs, func(chunk []int64) (JobStatus, error) {
+		data := struct {
+			Ticket *TicketUpdate `json:"ticket"`
+		}{update}
+
+		body, err := z.Put(ctx, "/tickets/update_many.json?ids="+joinIDs(chunk), data)
+		if err != nil {
+			return JobStatus{}, err
+		}
+		return decodeJobStatus(body)
+	})
+}
+
+func (u *TicketUpdate) set(field string, value interface{}) *TicketUpdate {
+	if u.fields == nil {
+		u.fields = map[string]interface{}{}
+	}
+	u.fields[field] = value
+	return u
+}
+
+func (u *TicketUpdate) setCustomField(cf CustomField) *TicketUpdate {
+	for i := range u.customFields {
+		if u.customFields[i].ID == cf.ID {
+			u.customFields[i] = cf
+			return u
+		}
+	}
+	u.customFields = append(u.customFields, cf)
+	return u
+}
+
+// diffField sets field to after if it changed, or clears it if after is the zero value
+func diffField[T comparable](u *TicketUpdate, field string, before, after T) {
+	var zero T
+	switch {
+	case before == after:
+	case after == zero:
+		u.set(field, nil)
+	default:
+		u.set(field, after)
+	}
+}
+
+// diffSlices returns the items of after missing from before, and the items of before missing from after
+func diffSlices[T comparable](before, after []T) (added, removed []T) {
+	for _, v := range after {
+		if !slices.Contains(before, v) {
+			added = append(added, v)
+		}
+	}
+	for _, v := range before {
+		if !slices.Contains(after, v) {
+			removed = append(removed, v)
+		}
+	}
+	return added, removed
+}
+
+func appendUnique[T comparable](list []T, items ...T) []T {
+	for _, item := range items {
+		if !slices.Contains(list, item) {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
+// userActions records action for every user, replacing any previous action for the same user
+func (u *TicketUpdate) userActions(actions []ticketUserAction, action string, users []TicketUser) []ticketUserAction {
+	for _, user := range users {
+		if user.ID == 0 && user.Email == "" {
+			u.err = errors.Join(u.err, errors.New("a follower or email CC needs an id or an email"))
+			continue
+		}
+		actions = slices.DeleteFunc(actions, func(a ticketUserAction) bool {
+			return user.ID != 0 && a.UserID == user.ID || user.Email != "" && a.UserEmail == user.Email
+		})
+		actions = append(actions, ticketUserAction{UserID: user.ID, UserEmail: user.Email, UserName: user.Name, Action: action})
+	}
+	return actions
+}
+
+func ticketUsers(userIDs []int64) []TicketUser {
+	users := make([]TicketUser, len(userIDs))
+	for i, id := range userIDs {
+		users[i] = TicketUser{ID: id}
+	}
+	return users
+}
+
+func equalCustomFields(a, b CustomField) bool {
+	aJSON, errA := json.Marshal(a.Value)
+	bJSON, errB := json.Marshal(b.Value)
+	return errA == nil && errB == nil && string(aJSON) == string(bJSON)
+}
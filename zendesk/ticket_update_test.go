@@ -0,0 +1,115 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/JacobPotter/go-zendesk/testhelper"
+)
+
+func TestTicketUpdateMarshal(t *testing.T) {
+	update := NewTicketUpdate().
+		SetStatus("pending").
+		ClearAssignee().
+		AddTags("waiting", "vip").
+		RemoveTags("new", "vip").
+		AddFollowers(10).
+		RemoveEmailCCs(11).
+		SetCustomField(360011748014, 3).
+		AddComment(TicketComment{Body: "Waiting for the customer"})
+
+	b, err := json.Marshal(update)
+	if err != nil {
+		t.Fatalf("Failed to marshal update: %s", err)
+	}
+	expected := `{"additional_tags":["waiting"],"assignee_id":null,` +
+		`"comment":{"body":"Waiting for the customer"},` +
+		`"custom_fields":[{"id":360011748014,"value":3}],` +
+		`"email_ccs":[{"user_id":11,"action":"delete"}],` +
+		`"followers":[{"user_id":10,"action":"put"}],` +
+		`"remove_tags":["new","vip"],"status":"pending"}`
+	if string(b) != expected {
+		t.Fatalf("Unexpected update\n%s\nexpected\n%s", b, expected)
+	}
+}
+
+func TestTicketUpdateInvalidCustomField(t *testing.T) {
+	update := NewTicketUpdate().SetCustomField(1, struct{}{})
+	if update.Err() == nil {
+		t.Fatal("Expected an error for an invalid custom field value")
+	}
+
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatalf("Unexpected request %s %s", r.Method, r.URL.Path)
+	}))
+	c := NewTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if _, err := c.UpdateTicketWith(ctx, 1, update); err == nil {
+		t.Fatal("Expected the update to be rejected")
+	}
+}
+
+func TestDiffTickets(t *testing.T) {
+	dueAt := NewTimestamp(time.Date(2024, time.March, 5, 0, 0, 0, 0, time.UTC))
+	before := Ticket{
+		Subject:      "Printer on fire",
+		Status:       "open",
+		AssigneeID:   5,
+		Tags:         []string{"printer", "fire"},
+		FollowerIDs:  []int64{1, 2},
+		DueAt:        dueAt,
+		CustomFields: []CustomField{{ID: 1, Value: "a"}, {ID: 2, Value: true}},
+	}
+	after := before
+	after.Status = "pending"
+	after.AssigneeID = 0
+	after.Tags = []string{"printer", "escalated"}
+	after.FollowerIDs = []int64{2, 3}
+	after.DueAt = nil
+	after.CustomFields = []CustomField{{ID: 1, Value: "a"}, {ID: 2, Value: false}}
+
+	b, err := json.Marshal(DiffTickets(before, after))
+	if err != nil {
+		t.Fatalf("Failed to marshal update: %s", err)
+	}
+	expected := `{"additional_tags":["escalated"],"assignee_id":null,` +
+		`"custom_fields":[{"id":2,"value":false}],"due_at":null,` +
+		`"followers":[{"user_id":3,"action":"put"},{"user_id":1,"action":"delete"}],` +
+		`"remove_tags":["fire"],"status":"pending"}`
+	if string(b) != expected {
+		t.Fatalf("Unexpected update\n%s\nexpected\n%s", b, expected)
+	}
+
+	if !DiffTickets(before, before).IsEmpty() {
+		t.Fatal("Expected no update between identical tickets")
+	}
+}
+
+func TestUpdateTicketWith(t *testing.T) {
+	var body []byte
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/tickets/2.json" {
+			t.Fatalf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		body, _ = io.ReadAll(r.Body)
+		_, err := w.Write(testhelper.ReadFixture(t, filepath.Join(http.MethodPut, "ticket.json")))
+		if err != nil {
+			t.Fatalf("Failed to write fixture: %s", err)
+		}
+	}))
+	c := NewTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if _, err := c.UpdateTicketWith(ctx, 2, NewTicketUpdate().AddTags("vip")); err != nil {
+		t.Fatalf("Failed to update ticket: %s", err)
+	}
+	if string(body) != `{"ticket":{"additional_tags":["vip"]}}` {
+		t.Fatalf("Unexpected request body %s", body)
+	}
+}
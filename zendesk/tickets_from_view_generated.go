@@ -1,9 +1,9 @@
 // Code generated by Script. DO NOT EDIT.
-// Source: script/codegen/main.go
+// Source: script/codegen/resources.yaml
 //
 // Generated by this command:
 //
-//	go run script/codegen/main.go
+//	go run ./script/codegen
 
 package zendesk
 
@@ -13,6 +13,7 @@ import (
 	"github.com/JacobPotter/go-zendesk/client"
 )
 
+// GetTicketsFromViewIterator returns an Iterator over /views/%d/tickets.json
 func (z *Client) GetTicketsFromViewIterator(ctx context.Context, opts *PaginationOptions) *Iterator[Ticket] {
 	return &Iterator[Ticket]{
 		CommonOptions: opts.CommonOptions,
@@ -27,9 +28,10 @@ func (z *Client) GetTicketsFromViewIterator(ctx context.Context, opts *Paginatio
 	}
 }
 
+// GetTicketsFromViewOBP fetches a page of /views/%d/tickets.json with offset based pagination
 func (z *Client) GetTicketsFromViewOBP(ctx context.Context, opts *OBPOptions) ([]Ticket, Page, error) {
 	var data struct {
-		Tickets []Ticket `json:"tickets"`
+		TicketsFromView []Ticket `json:"tickets"`
 		Page
 	}
 
@@ -39,23 +41,23 @@ func (z *Client) GetTicketsFromViewOBP(ctx context.Context, opts *OBPOptions) ([
 	}
 
 	path := fmt.Sprintf("/views/%d/tickets.json", tmp.Id)
-	u, err := client.AddOptions(path, tmp)
-
+	u, err := tmp.addOptions(path)
 	if err != nil {
 		return nil, Page{}, err
 	}
 
-	err = client.GetData(z, ctx, u, &data)
+	err = z.getData(ctx, u, &data)
 	if err != nil {
 		return nil, Page{}, err
 	}
-	return data.Tickets, data.Page, nil
+	return data.TicketsFromView, data.Page, nil
 }
 
+// GetTicketsFromViewCBP fetches a page of /views/%d/tickets.json with cursor based pagination
 func (z *Client) GetTicketsFromViewCBP(ctx context.Context, opts *CBPOptions) ([]Ticket, client.CursorPaginationMeta, error) {
 	var data struct {
-		Tickets []Ticket                    `json:"tickets"`
-		Meta    client.CursorPaginationMeta `json:"meta"`
+		TicketsFromView []Ticket                    `json:"tickets"`
+		Meta            client.CursorPaginationMeta `json:"meta"`
 	}
 
 	tmp := opts
@@ -64,15 +66,14 @@ func (z *Client) GetTicketsFromViewCBP(ctx context.Context, opts *CBPOptions) ([
 	}
 
 	path := fmt.Sprintf("/views/%d/tickets.json", tmp.Id)
-	u, err := client.AddOptions(path, tmp)
-
+	u, err := tmp.addOptions(path)
 	if err != nil {
 		return nil, data.Meta, err
 	}
 
-	err = client.GetData(z, ctx, u, &data)
+	err = z.getData(ctx, u, &data)
 	if err != nil {
 		return nil, data.Meta, err
 	}
-	return data.Tickets, data.Meta, nil
+	return data.TicketsFromView, data.Meta, nil
 }
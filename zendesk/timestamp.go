@@ -0,0 +1,106 @@
+package zendesk
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// timestampLayouts are the formats of the timestamps returned by Zendesk, most common first
+var timestampLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05Z0700",
+	"2006-01-02 15:04:05 -0700",
+	"2006/01/02 15:04:05 -0700",
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+	"20060102150405",
+	"20060102",
+}
+
+// Timestamp is the type of the timestamps of every model. Model fields are *Timestamp tagged omitempty so
+// that an unset timestamp, e.g. the created_at of an item being created, is left out of request payloads.
+//
+// Timestamps are read from RFC 3339 strings as well as the other formats returned by some endpoints,
+// and from unix timestamps in seconds. They are written in RFC 3339.
+type Timestamp struct {
+	time.Time
+}
+
+// NewTimestamp returns t as a *Timestamp to set a model field, or nil if t is zero
+func NewTimestamp(t time.Time) *Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return &Timestamp{Time: t}
+}
+
+// AsTime returns the time of ts, or the zero time if ts is nil
+func (ts *Timestamp) AsTime() time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.Time
+}
+
+// Ptr returns a pointer to the time of ts, or nil if ts is nil or zero
+func (ts *Timestamp) Ptr() *time.Time {
+	if ts == nil || ts.IsZero() {
+		return nil
+	}
+	t := ts.Time
+	return &t
+}
+
+// MarshalJSON is marshaller for Timestamp. A zero Timestamp is written as null.
+func (ts Timestamp) MarshalJSON() ([]byte, error) {
+	if ts.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(ts.Format(time.RFC3339))
+}
+
+// UnmarshalJSON is unmarshaller for Timestamp. null and empty strings are read as a zero Timestamp.
+func (ts *Timestamp) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		*ts = Timestamp{}
+		return nil
+	}
+
+	if len(data) > 0 && data[0] != '"' {
+		seconds, err := strconv.ParseInt(string(data), 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid timestamp %s", data)
+		}
+		*ts = Timestamp{Time: time.Unix(seconds, 0).UTC()}
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	t, err := ParseTimestamp(s)
+	if err != nil {
+		return err
+	}
+	*ts = t
+	return nil
+}
+
+// ParseTimestamp parses a timestamp in any of the formats returned by Zendesk. An empty string is
+// a zero Timestamp.
+func ParseTimestamp(s string) (Timestamp, error) {
+	if s == "" {
+		return Timestamp{}, nil
+	}
+	for _, layout := range timestampLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return Timestamp{Time: t}, nil
+		}
+	}
+	return Timestamp{}, fmt.Errorf("invalid timestamp %q", s)
+}
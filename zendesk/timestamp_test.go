@@ -0,0 +1,77 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTimestampFormats(t *testing.T) {
+	expected := time.Date(2023, time.June, 6, 10, 2, 4, 0, time.UTC)
+
+	for _, input := range []string{
+		`"2023-06-06T10:02:04Z"`,
+		`"2023-06-06T10:02:04.000Z"`,
+		`"2023-06-06T12:02:04+02:00"`,
+		`"2023-06-06T12:02:04+0200"`,
+		`"2023-06-06 12:02:04 +0200"`,
+		`"2023/06/06 12:02:04 +0200"`,
+		`"20230606100204"`,
+		`1686045724`,
+	} {
+		var ts Timestamp
+		if err := json.Unmarshal([]byte(input), &ts); err != nil {
+			t.Fatalf("Failed to unmarshal %s: %s", input, err)
+		}
+		if !ts.Equal(expected) {
+			t.Errorf("Expected %s to be %s, got %s", input, expected, ts)
+		}
+	}
+
+	for _, input := range []string{`null`, `""`} {
+		ts := NewTimestamp(expected)
+		if err := json.Unmarshal([]byte(input), &ts); err != nil || !ts.AsTime().IsZero() {
+			t.Errorf("Expected %s to be a zero timestamp, got %s, %v", input, ts, err)
+		}
+	}
+
+	if ts := NewTimestamp(time.Time{}); ts != nil || ts.Ptr() != nil {
+		t.Errorf("Expected a zero time to be a nil timestamp, got %s", ts)
+	}
+
+	var ts Timestamp
+	if err := json.Unmarshal([]byte(`"yesterday"`), &ts); err == nil {
+		t.Error("Expected an error for an invalid timestamp")
+	}
+}
+
+// TestZeroTimestampsAreOmitted marshals every model with timestamps to make sure that unset timestamps
+// are not sent as 0001-01-01T00:00:00Z
+func TestZeroTimestampsAreOmitted(t *testing.T) {
+	models := []interface{}{
+		AppInstallation{}, Automation{}, Brand{}, CustomObjectRecord{}, CustomRole{}, DeletedTicket{},
+		DynamicContentItem{}, DynamicContentVariant{}, Group{}, GroupMembership{}, Locale{}, Macro{},
+		Organization{}, OrganizationField{}, OrganizationMembership{}, SatisfactionRating{}, SatisfactionReason{},
+		Schedule{}, SLAPolicy{},
+		SuspendedTicket{}, Target{}, Ticket{}, TicketAudit{}, TicketComment{}, TicketDates{}, TicketEvent{},
+		TicketField{}, TicketForm{}, TicketImport{}, TicketMetric{}, TicketMetricEvent{}, Topic{}, Trigger{},
+		TriggerCategory{}, User{}, UserField{}, View{}, Webhook{},
+	}
+
+	for _, model := range models {
+		b, err := json.Marshal(model)
+		if err != nil {
+			t.Errorf("Failed to marshal %T: %s", model, err)
+			continue
+		}
+		if strings.Contains(string(b), "0001-01-01") {
+			t.Errorf("%T leaks a zero timestamp: %s", model, b)
+		}
+		for _, key := range []string{`"created_at"`, `"updated_at"`} {
+			if strings.Contains(string(b), key) {
+				t.Errorf("%T sends a zero %s: %s", model, key, b)
+			}
+		}
+	}
+}
@@ -2,10 +2,9 @@ package zendesk
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
 	client2 "github.com/JacobPotter/go-zendesk/client"
-	"time"
 )
 
 // Trigger is zendesk trigger JSON payload format
@@ -20,9 +19,12 @@ type Trigger struct {
 	Actions     []Action   `json:"actions"`
 	Description string     `json:"description,omitempty"`
 	CategoryID  string     `json:"category_id,omitempty"`
-	CreatedAt   *time.Time `json:"created_at,omitempty"`
-	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+	CreatedAt   *Timestamp `json:"created_at,omitempty"`
+	UpdatedAt   *Timestamp `json:"updated_at,omitempty"`
 	URL         string     `json:"url,omitempty"`
+
+	// Extra keeps the trigger fields this struct lacks, see SetKeepExtraFields
+	Extra ExtraFields `json:"-"`
 }
 
 // TriggerListOptions is options for GetTriggers
@@ -36,6 +38,33 @@ type TriggerListOptions struct {
 	SortOrder  string `url:"sort_order,omitempty"`
 }
 
+// TriggerIteratorOptions are the options accepted by IterateTriggers
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/triggers/#list-triggers
+type TriggerIteratorOptions struct {
+	IteratorOptions
+
+	// Active filters by active or inactive triggers when set
+	Active     *bool  `url:"active,omitempty"`
+	CategoryID string `url:"category_id,omitempty"`
+
+	// SortBy is used by OBP and can take "alphabetical", "created_at", "updated_at", "usage_1h",
+	// "usage_24h", "usage_7d", "usage_30d" or "position"
+	SortBy string `url:"sort_by,omitempty"`
+
+	// SortOrder is used by OBP and can take "asc" or "desc"
+	SortOrder string `url:"sort_order,omitempty"`
+}
+
+// Validate checks the sort options of TriggerIteratorOptions
+func (o TriggerIteratorOptions) Validate() error {
+	return errors.Join(
+		validateOption("sort_by", o.SortBy, businessRuleSortByValues...),
+		validateOption("sort_order", o.SortOrder, "asc", "desc"),
+		o.validateSortMode("", o.SortBy, o.SortOrder),
+	)
+}
+
 // TriggerAPI an interface containing all trigger related methods
 type TriggerAPI interface {
 	GetTriggers(ctx context.Context, opts *TriggerListOptions) ([]Trigger, Page, error)
@@ -46,6 +75,7 @@ type TriggerAPI interface {
 	GetTriggersIterator(ctx context.Context, opts *PaginationOptions) *Iterator[Trigger]
 	GetTriggersOBP(ctx context.Context, opts *OBPOptions) ([]Trigger, Page, error)
 	GetTriggersCBP(ctx context.Context, opts *CBPOptions) ([]Trigger, client2.CursorPaginationMeta, error)
+	IterateTriggers(ctx context.Context, opts *TriggerIteratorOptions) *Iterator[Trigger]
 }
 
 // GetTriggers fetch trigger list
@@ -71,7 +101,7 @@ func (z *Client) GetTriggers(ctx context.Context, opts *TriggerListOptions) ([]T
 		return []Trigger{}, Page{}, err
 	}
 
-	err = json.Unmarshal(body, &data)
+	err = z.unmarshal(body, &data)
 	if err != nil {
 		return []Trigger{}, Page{}, err
 	}
@@ -87,18 +117,28 @@ func (z *Client) CreateTrigger(ctx context.Context, trigger Trigger) (Trigger, e
 	}
 	data.Trigger = trigger
 
-	body, err := z.Post(ctx, "/triggers.json", data)
+	body, err := z.Post(ctx, "/triggers.json", withExtraFields(data))
 	if err != nil {
 		return Trigger{}, err
 	}
 
-	err = json.Unmarshal(body, &result)
+	err = z.unmarshal(body, &result)
 	if err != nil {
 		return Trigger{}, err
 	}
 	return result.Trigger, nil
 }
 
+// IterateTriggers returns an Iterator over all triggers
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/business-rules/triggers/#list-triggers
+func (z *Client) IterateTriggers(ctx context.Context, opts *TriggerIteratorOptions) *Iterator[Trigger] {
+	if opts == nil {
+		opts = &TriggerIteratorOptions{}
+	}
+	return newListIterator(ctx, opts.IteratorOptions, *opts, 0, z.GetTriggersOBP, z.GetTriggersCBP)
+}
+
 // GetTrigger returns the specified trigger
 //
 // ref: https://developer.zendesk.com/rest_api/docs/support/triggers#getting-triggers
@@ -112,7 +152,7 @@ func (z *Client) GetTrigger(ctx context.Context, id int64) (Trigger, error) {
 		return Trigger{}, err
 	}
 
-	err = json.Unmarshal(body, &result)
+	err = z.unmarshal(body, &result)
 	if err != nil {
 		return Trigger{}, err
 	}
@@ -128,12 +168,12 @@ func (z *Client) UpdateTrigger(ctx context.Context, id int64, trigger Trigger) (
 	}
 
 	data.Trigger = trigger
-	body, err := z.Put(ctx, fmt.Sprintf("/triggers/%d.json", id), data)
+	body, err := z.Put(ctx, fmt.Sprintf("/triggers/%d.json", id), withExtraFields(data))
 	if err != nil {
 		return Trigger{}, err
 	}
 
-	err = json.Unmarshal(body, &result)
+	err = z.unmarshal(body, &result)
 	if err != nil {
 		return Trigger{}, err
 	}
@@ -5,15 +5,14 @@ import (
 	"encoding/json"
 	"fmt"
 	client2 "github.com/JacobPotter/go-zendesk/client"
-	"time"
 )
 
 type TriggerCategory struct {
-	ID        string    `json:"id"`
-	CreatedAt time.Time `json:"created_at"`
-	Name      string    `json:"name"`
-	Position  int64     `json:"position"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID        string     `json:"id"`
+	CreatedAt *Timestamp `json:"created_at,omitempty"`
+	Name      string     `json:"name"`
+	Position  int64      `json:"position"`
+	UpdatedAt *Timestamp `json:"updated_at,omitempty"`
 }
 
 // TriggerCategoryListOptions is options for GetTriggers
@@ -1,9 +1,9 @@
 // Code generated by Script. DO NOT EDIT.
-// Source: script/codegen/main.go
+// Source: script/codegen/resources.yaml
 //
 // Generated by this command:
 //
-//	go run script/codegen/main.go
+//	go run ./script/codegen
 
 package zendesk
 
@@ -12,6 +12,7 @@ import (
 	"github.com/JacobPotter/go-zendesk/client"
 )
 
+// GetTriggersIterator returns an Iterator over /triggers.json
 func (z *Client) GetTriggersIterator(ctx context.Context, opts *PaginationOptions) *Iterator[Trigger] {
 	return &Iterator[Trigger]{
 		CommonOptions: opts.CommonOptions,
@@ -26,6 +27,7 @@ func (z *Client) GetTriggersIterator(ctx context.Context, opts *PaginationOption
 	}
 }
 
+// GetTriggersOBP fetches a page of /triggers.json with offset based pagination
 func (z *Client) GetTriggersOBP(ctx context.Context, opts *OBPOptions) ([]Trigger, Page, error) {
 	var data struct {
 		Triggers []Trigger `json:"triggers"`
@@ -37,19 +39,19 @@ func (z *Client) GetTriggersOBP(ctx context.Context, opts *OBPOptions) ([]Trigge
 		tmp = &OBPOptions{}
 	}
 
-	u, err := client.AddOptions("/triggers.json", tmp)
-
+	u, err := tmp.addOptions("/triggers.json")
 	if err != nil {
 		return nil, Page{}, err
 	}
 
-	err = client.GetData(z, ctx, u, &data)
+	err = z.getData(ctx, u, &data)
 	if err != nil {
 		return nil, Page{}, err
 	}
 	return data.Triggers, data.Page, nil
 }
 
+// GetTriggersCBP fetches a page of /triggers.json with cursor based pagination
 func (z *Client) GetTriggersCBP(ctx context.Context, opts *CBPOptions) ([]Trigger, client.CursorPaginationMeta, error) {
 	var data struct {
 		Triggers []Trigger                   `json:"triggers"`
@@ -61,13 +63,12 @@ func (z *Client) GetTriggersCBP(ctx context.Context, opts *CBPOptions) ([]Trigge
 		tmp = &CBPOptions{}
 	}
 
-	u, err := client.AddOptions("/triggers.json", tmp)
-
+	u, err := tmp.addOptions("/triggers.json")
 	if err != nil {
 		return nil, data.Meta, err
 	}
 
-	err = client.GetData(z, ctx, u, &data)
+	err = z.getData(ctx, u, &data)
 	if err != nil {
 		return nil, data.Meta, err
 	}
@@ -3,9 +3,9 @@ package zendesk
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/JacobPotter/go-zendesk/client"
-	"time"
 )
 
 // UserFields is a dictionary of custom user related fields
@@ -50,9 +50,9 @@ type User struct {
 	UserFields           UserFields `json:"user_fields"`
 	Verified             bool       `json:"verified,omitempty"`
 	ReportCSV            bool       `json:"report_csv,omitempty"`
-	LastLoginAt          time.Time  `json:"last_login_at,omitempty"`
-	CreatedAt            time.Time  `json:"created_at,omitempty"`
-	UpdatedAt            time.Time  `json:"updated_at,omitempty"`
+	LastLoginAt          *Timestamp `json:"last_login_at,omitempty"`
+	CreatedAt            *Timestamp `json:"created_at,omitempty"`
+	UpdatedAt            *Timestamp `json:"updated_at,omitempty"`
 }
 
 const (
@@ -80,6 +80,63 @@ type UserListOptions struct {
 	PermissionSet int64    `url:"permission_set,omitempty"`
 }
 
+// UserIteratorOptions are the options accepted by IterateUsers
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/users/#list-users
+type UserIteratorOptions struct {
+	IteratorOptions
+
+	// Role can take "end-user", "agent" or "admin"
+	Role string `url:"role,omitempty"`
+
+	// Roles takes the same values as Role and filters by any of them
+	Roles         []string `url:"role[],omitempty"`
+	PermissionSet int64    `url:"permission_set,omitempty"`
+	ExternalID    string   `url:"external_id,omitempty"`
+
+	// Include can take a comma separated list of organizations and groups.
+	// The side-loads of each page are returned by Iterator.Sideloads.
+	Include string `url:"include,omitempty"`
+}
+
+// Validate checks the roles and include options of UserIteratorOptions
+func (o UserIteratorOptions) Validate() error {
+	return errors.Join(validateUserRoles(o.Role, o.Roles), validateInclude(o.Include, userSideloads...))
+}
+
+// OrganizationUserIteratorOptions are the options accepted by IterateOrganizationUsers
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/users/#list-users
+type OrganizationUserIteratorOptions struct {
+	IteratorOptions
+
+	// OrganizationID is required
+	OrganizationID int64 `url:"-"`
+
+	// Role can take "end-user", "agent" or "admin"
+	Role string `url:"role,omitempty"`
+
+	// Roles takes the same values as Role and filters by any of them
+	Roles         []string `url:"role[],omitempty"`
+	PermissionSet int64    `url:"permission_set,omitempty"`
+}
+
+// Validate checks the organization id and roles of OrganizationUserIteratorOptions
+func (o OrganizationUserIteratorOptions) Validate() error {
+	return errors.Join(requireID("organization_id", o.OrganizationID), validateUserRoles(o.Role, o.Roles))
+}
+
+// userRoles are the role values accepted by the user list endpoints
+var userRoles = []string{"end-user", "agent", "admin"}
+
+func validateUserRoles(role string, roles []string) error {
+	errs := []error{validateOption("role", role, userRoles...)}
+	for _, r := range roles {
+		errs = append(errs, validateOption("role", r, userRoles...))
+	}
+	return errors.Join(errs...)
+}
+
 // UserRoleText takes role type and returns role name string
 func UserRoleText(role int) string {
 	return userRoleText[role]
@@ -119,9 +176,11 @@ type UserAPI interface {
 	GetUsers(ctx context.Context, opts *UserListOptions) ([]User, Page, error)
 	GetOrganizationUsers(ctx context.Context, orgID int64, opts *UserListOptions) ([]User, Page, error)
 	GetUser(ctx context.Context, userID int64) (User, error)
+	GetUserWithSideloads(ctx context.Context, userID int64, include string) (User, Sideloads, error)
 	CreateUser(ctx context.Context, user User) (User, error)
 	CreateOrUpdateUser(ctx context.Context, user User) (User, error)
 	UpdateUser(ctx context.Context, userID int64, user User) (User, error)
+	PatchUser(ctx context.Context, userID int64, patch UserPatch) (User, error)
 	GetUserRelated(ctx context.Context, userID int64) (UserRelated, error)
 	GetUsersIterator(ctx context.Context, opts *PaginationOptions) *Iterator[User]
 	GetUsersOBP(ctx context.Context, opts *OBPOptions) ([]User, Page, error)
@@ -129,6 +188,8 @@ type UserAPI interface {
 	GetOrganizationUsersIterator(ctx context.Context, opts *PaginationOptions) *Iterator[User]
 	GetOrganizationUsersOBP(ctx context.Context, opts *OBPOptions) ([]User, Page, error)
 	GetOrganizationUsersCBP(ctx context.Context, opts *CBPOptions) ([]User, client.CursorPaginationMeta, error)
+	IterateUsers(ctx context.Context, opts *UserIteratorOptions) *Iterator[User]
+	IterateOrganizationUsers(ctx context.Context, opts *OrganizationUserIteratorOptions) *Iterator[User]
 }
 
 // GetUsers fetch user list
@@ -161,6 +222,28 @@ func (z *Client) GetUsers(ctx context.Context, opts *UserListOptions) ([]User, P
 	return data.Users, data.Page, nil
 }
 
+// IterateUsers returns an Iterator over all users
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/users/#list-users
+func (z *Client) IterateUsers(ctx context.Context, opts *UserIteratorOptions) *Iterator[User] {
+	if opts == nil {
+		opts = &UserIteratorOptions{}
+	}
+	return newSideloadIterator[User](ctx, z, opts.IteratorOptions, *opts, "/users.json", "users")
+}
+
+// IterateOrganizationUsers returns an Iterator over the users of an organization
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/users/#list-users
+func (z *Client) IterateOrganizationUsers(ctx context.Context, opts *OrganizationUserIteratorOptions) *Iterator[User] {
+	if opts == nil {
+		opts = &OrganizationUserIteratorOptions{}
+	}
+	return newListIterator(
+		ctx, opts.IteratorOptions, *opts, opts.OrganizationID, z.GetOrganizationUsersOBP, z.GetOrganizationUsersCBP,
+	)
+}
+
 // GetOrganizationUsers fetch organization users list
 // https://developer.zendesk.com/api-reference/ticketing/users/users/#list-users
 // /api/v2/organizations/{organization_id}/users
@@ -320,7 +403,16 @@ func (z *Client) GetUser(ctx context.Context, userID int64) (User, error) {
 	return result.User, nil
 }
 
+// GetUserWithSideloads gets a specified user together with the side-loads listed in include,
+// a comma separated list of organizations and groups
+//
+// ref: https://developer.zendesk.com/documentation/ticketing/using-the-zendesk-api/side_loading/
+func (z *Client) GetUserWithSideloads(ctx context.Context, userID int64, include string) (User, Sideloads, error) {
+	return getWithSideloads[User](z, ctx, fmt.Sprintf("/users/%d.json", userID), "user", include, userSideloads...)
+}
+
 // UpdateUser update an existing user
+// Fields with a zero value are left out, use PatchUser to set them to false, empty or null.
 // ref: https://developer.zendesk.com/rest_api/docs/support/users#update-user
 func (z *Client) UpdateUser(ctx context.Context, userID int64, user User) (User, error) {
 	var data, result struct {
@@ -358,3 +450,43 @@ func (z *Client) GetUserRelated(ctx context.Context, userID int64) (UserRelated,
 
 	return data.UserRelated, nil
 }
+
+// UserPatch is a partial update of a user. Only the fields which are set are sent, and fields set to
+// Null are cleared, e.g. to set Suspended back to false which UpdateUser leaves out as a zero value.
+type UserPatch struct {
+	Name                Nullable[string]                 `json:"name"`
+	Email               Nullable[string]                 `json:"email"`
+	Alias               Nullable[string]                 `json:"alias"`
+	Details             Nullable[string]                 `json:"details"`
+	Notes               Nullable[string]                 `json:"notes"`
+	Phone               Nullable[string]                 `json:"phone"`
+	ExternalID          Nullable[string]                 `json:"external_id"`
+	Locale              Nullable[string]                 `json:"locale"`
+	Timezone            Nullable[string]                 `json:"time_zone"`
+	Role                Nullable[string]                 `json:"role"`
+	CustomRoleID        Nullable[int64]                  `json:"custom_role_id"`
+	OrganizationID      Nullable[int64]                  `json:"organization_id"`
+	DefaultGroupID      Nullable[int64]                  `json:"default_group_id"`
+	Signature           Nullable[string]                 `json:"signature"`
+	TicketRestriction   Nullable[string]                 `json:"ticket_restriction"`
+	Moderator           Nullable[bool]                   `json:"moderator"`
+	OnlyPrivateComments Nullable[bool]                   `json:"only_private_comments"`
+	RestrictedAgent     Nullable[bool]                   `json:"restricted_agent"`
+	Suspended           Nullable[bool]                   `json:"suspended"`
+	Verified            Nullable[bool]                   `json:"verified"`
+	Tags                Nullable[[]string]               `json:"tags"`
+	UserFields          Nullable[map[string]interface{}] `json:"user_fields"`
+}
+
+// MarshalJSON is marshaller for UserPatch, which leaves out the fields which are not set
+func (p UserPatch) MarshalJSON() ([]byte, error) {
+	return marshalPatch(p)
+}
+
+// PatchUser updates the fields set in patch. Unlike UpdateUser, fields can be set to their zero value
+// or cleared.
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/users/users/#update-user
+func (z *Client) PatchUser(ctx context.Context, userID int64, patch UserPatch) (User, error) {
+	return putPatch[User](z, ctx, fmt.Sprintf("/users/%d.json", userID), "user", patch)
+}
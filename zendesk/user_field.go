@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/JacobPotter/go-zendesk/client"
-	"time"
 )
 
 // UserField is struct for user_field payload
@@ -24,8 +23,8 @@ type UserField struct {
 	RegexpForValidation    string              `json:"regexp_for_validation,omitempty"`
 	Tag                    string              `json:"tag,omitempty"`
 	CustomFieldOptions     []CustomFieldOption `json:"custom_field_options,omitempty"`
-	CreatedAt              time.Time           `json:"created_at,omitempty"`
-	UpdatedAt              time.Time           `json:"updated_at,omitempty"`
+	CreatedAt              *Timestamp          `json:"created_at,omitempty"`
+	UpdatedAt              *Timestamp          `json:"updated_at,omitempty"`
 	RelationshipTargetType string              `json:"relationship_target_type,omitempty"`
 	RelationshipFilter     RelationshipFilter  `json:"relationship_filter,omitempty"`
 }
@@ -1,9 +1,9 @@
 // Code generated by Script. DO NOT EDIT.
-// Source: script/codegen/main.go
+// Source: script/codegen/resources.yaml
 //
 // Generated by this command:
 //
-//	go run script/codegen/main.go
+//	go run ./script/codegen
 
 package zendesk
 
@@ -12,6 +12,7 @@ import (
 	"github.com/JacobPotter/go-zendesk/client"
 )
 
+// GetUserFieldsIterator returns an Iterator over /user_fields.json
 func (z *Client) GetUserFieldsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[UserField] {
 	return &Iterator[UserField]{
 		CommonOptions: opts.CommonOptions,
@@ -26,6 +27,7 @@ func (z *Client) GetUserFieldsIterator(ctx context.Context, opts *PaginationOpti
 	}
 }
 
+// GetUserFieldsOBP fetches a page of /user_fields.json with offset based pagination
 func (z *Client) GetUserFieldsOBP(ctx context.Context, opts *OBPOptions) ([]UserField, Page, error) {
 	var data struct {
 		UserFields []UserField `json:"user_fields"`
@@ -37,19 +39,19 @@ func (z *Client) GetUserFieldsOBP(ctx context.Context, opts *OBPOptions) ([]User
 		tmp = &OBPOptions{}
 	}
 
-	u, err := client.AddOptions("/user_fields.json", tmp)
-
+	u, err := tmp.addOptions("/user_fields.json")
 	if err != nil {
 		return nil, Page{}, err
 	}
 
-	err = client.GetData(z, ctx, u, &data)
+	err = z.getData(ctx, u, &data)
 	if err != nil {
 		return nil, Page{}, err
 	}
 	return data.UserFields, data.Page, nil
 }
 
+// GetUserFieldsCBP fetches a page of /user_fields.json with cursor based pagination
 func (z *Client) GetUserFieldsCBP(ctx context.Context, opts *CBPOptions) ([]UserField, client.CursorPaginationMeta, error) {
 	var data struct {
 		UserFields []UserField                 `json:"user_fields"`
@@ -61,15 +63,32 @@ func (z *Client) GetUserFieldsCBP(ctx context.Context, opts *CBPOptions) ([]User
 		tmp = &CBPOptions{}
 	}
 
-	u, err := client.AddOptions("/user_fields.json", tmp)
-
+	u, err := tmp.addOptions("/user_fields.json")
 	if err != nil {
 		return nil, data.Meta, err
 	}
 
-	err = client.GetData(z, ctx, u, &data)
+	err = z.getData(ctx, u, &data)
 	if err != nil {
 		return nil, data.Meta, err
 	}
 	return data.UserFields, data.Meta, nil
 }
+
+// UserFieldIteratorOptions are the options accepted by IterateUserFields
+type UserFieldIteratorOptions struct {
+	IteratorOptions
+}
+
+// Validate checks the options of UserFieldIteratorOptions
+func (o UserFieldIteratorOptions) Validate() error {
+	return nil
+}
+
+// IterateUserFields returns an Iterator over /user_fields.json
+func (z *Client) IterateUserFields(ctx context.Context, opts *UserFieldIteratorOptions) *Iterator[UserField] {
+	if opts == nil {
+		opts = &UserFieldIteratorOptions{}
+	}
+	return newListIterator(ctx, opts.IteratorOptions, *opts, 0, z.GetUserFieldsOBP, z.GetUserFieldsCBP)
+}
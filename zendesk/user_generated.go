@@ -1,9 +1,9 @@
 // Code generated by Script. DO NOT EDIT.
-// Source: script/codegen/main.go
+// Source: script/codegen/resources.yaml
 //
 // Generated by this command:
 //
-//	go run script/codegen/main.go
+//	go run ./script/codegen
 
 package zendesk
 
@@ -12,6 +12,7 @@ import (
 	"github.com/JacobPotter/go-zendesk/client"
 )
 
+// GetUsersIterator returns an Iterator over /users.json
 func (z *Client) GetUsersIterator(ctx context.Context, opts *PaginationOptions) *Iterator[User] {
 	return &Iterator[User]{
 		CommonOptions: opts.CommonOptions,
@@ -26,6 +27,7 @@ func (z *Client) GetUsersIterator(ctx context.Context, opts *PaginationOptions)
 	}
 }
 
+// GetUsersOBP fetches a page of /users.json with offset based pagination
 func (z *Client) GetUsersOBP(ctx context.Context, opts *OBPOptions) ([]User, Page, error) {
 	var data struct {
 		Users []User `json:"users"`
@@ -37,19 +39,19 @@ func (z *Client) GetUsersOBP(ctx context.Context, opts *OBPOptions) ([]User, Pag
 		tmp = &OBPOptions{}
 	}
 
-	u, err := client.AddOptions("/users.json", tmp)
-
+	u, err := tmp.addOptions("/users.json")
 	if err != nil {
 		return nil, Page{}, err
 	}
 
-	err = client.GetData(z, ctx, u, &data)
+	err = z.getData(ctx, u, &data)
 	if err != nil {
 		return nil, Page{}, err
 	}
 	return data.Users, data.Page, nil
 }
 
+// GetUsersCBP fetches a page of /users.json with cursor based pagination
 func (z *Client) GetUsersCBP(ctx context.Context, opts *CBPOptions) ([]User, client.CursorPaginationMeta, error) {
 	var data struct {
 		Users []User                      `json:"users"`
@@ -61,13 +63,12 @@ func (z *Client) GetUsersCBP(ctx context.Context, opts *CBPOptions) ([]User, cli
 		tmp = &CBPOptions{}
 	}
 
-	u, err := client.AddOptions("/users.json", tmp)
-
+	u, err := tmp.addOptions("/users.json")
 	if err != nil {
 		return nil, data.Meta, err
 	}
 
-	err = client.GetData(z, ctx, u, &data)
+	err = z.getData(ctx, u, &data)
 	if err != nil {
 		return nil, data.Meta, err
 	}
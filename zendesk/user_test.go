@@ -16,6 +16,14 @@ func TestUserRoleText(t *testing.T) {
 	}
 }
 
+func TestUserIteratorOptionsInvalidRole(t *testing.T) {
+	err := UserIteratorOptions{Roles: []string{"agent", "owner"}}.Validate()
+	expected := `invalid role "owner": must be one of end-user, agent, admin`
+	if err == nil || err.Error() != expected {
+		t.Fatalf("expected error %q, got %v", expected, err)
+	}
+}
+
 func TestGetUsers(t *testing.T) {
 	mockAPI := testhelper.NewMockAPI(t, http.MethodGet, "users.json")
 	c := NewTestClient(mockAPI)
@@ -237,3 +245,19 @@ func TestGetUserRelated(t *testing.T) {
 		t.Fatalf("Returned user does not have the expected assigned tickets %d. It is %d", expectedAssignedTickets, userRelated.AssignedTickets)
 	}
 }
+
+func TestIterateUsersSideloads(t *testing.T) {
+	mockAPI := testhelper.NewMockAPI(t, http.MethodGet, "users_sideloads.json")
+	c := NewTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	it := c.IterateUsers(ctx, &UserIteratorOptions{Include: "organizations"})
+	users, err := it.GetNext()
+	if err != nil {
+		t.Fatalf("Failed to get users: %s", err)
+	}
+	sideloads := it.Sideloads()
+	if len(users) != 1 || len(sideloads.Users) != 0 || len(sideloads.Organizations) != 1 {
+		t.Fatalf("Unexpected users %v and side-loads %v", users, sideloads)
+	}
+}
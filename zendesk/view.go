@@ -16,8 +16,8 @@ type (
 		ID          int64       `json:"id,omitempty"`
 		Title       string      `json:"title,omitempty"`
 		Active      bool        `json:"active"`
-		UpdatedAt   string      `json:"updated_at,omitempty"`
-		CreatedAt   string      `json:"created_at,omitempty"`
+		UpdatedAt   *Timestamp  `json:"updated_at,omitempty"`
+		CreatedAt   *Timestamp  `json:"created_at,omitempty"`
 		Default     bool        `json:"default,omitempty"`
 		Position    int64       `json:"position,omitempty"`
 		Description string      `json:"description,omitempty"`
@@ -28,6 +28,9 @@ type (
 		All         []Condition `json:"all,omitempty"`
 		Any         []Condition `json:"any,omitempty"`
 		Output      ViewOutput  `json:"output,omitempty"`
+
+		// Extra keeps the view fields this struct lacks, see SetKeepExtraFields
+		Extra ExtraFields `json:"-"`
 	}
 
 	ViewOutput struct {
@@ -185,7 +188,7 @@ func (z *Client) GetViews(ctx context.Context) ([]View, Page, error) {
 		return []View{}, Page{}, err
 	}
 
-	if err := json.Unmarshal(body, &result); err != nil {
+	if err := z.unmarshal(body, &result); err != nil {
 		return []View{}, Page{}, err
 	}
 
@@ -205,7 +208,7 @@ func (z *Client) GetView(ctx context.Context, viewID int64) (View, error) {
 		return View{}, err
 	}
 
-	if err := json.Unmarshal(body, &result); err != nil {
+	if err := z.unmarshal(body, &result); err != nil {
 		return View{}, err
 	}
 
@@ -219,13 +222,13 @@ func (z *Client) CreateView(ctx context.Context, newView View) (View, error) {
 
 	data.View = newView
 
-	body, err := z.Post(ctx, "/views.json", data)
+	body, err := z.Post(ctx, "/views.json", withExtraFields(data))
 
 	if err != nil {
 		return View{}, err
 	}
 
-	if err := json.Unmarshal(body, &result); err != nil {
+	if err := z.unmarshal(body, &result); err != nil {
 		return View{}, err
 	}
 
@@ -238,13 +241,13 @@ func (z *Client) UpdateView(ctx context.Context, updatedViewId int64, updatedVie
 	}
 
 	data.View = updatedView
-	body, err := z.Put(ctx, fmt.Sprintf("/views/%d.json", updatedViewId), data)
+	body, err := z.Put(ctx, fmt.Sprintf("/views/%d.json", updatedViewId), withExtraFields(data))
 
 	if err != nil {
 		return View{}, err
 	}
 
-	if err := json.Unmarshal(body, &result); err != nil {
+	if err := z.unmarshal(body, &result); err != nil {
 		return View{}, err
 	}
 
@@ -1,9 +1,9 @@
 // Code generated by Script. DO NOT EDIT.
-// Source: script/codegen/main.go
+// Source: script/codegen/resources.yaml
 //
 // Generated by this command:
 //
-//	go run script/codegen/main.go
+//	go run ./script/codegen
 
 package zendesk
 
@@ -12,6 +12,7 @@ import (
 	"github.com/JacobPotter/go-zendesk/client"
 )
 
+// GetViewsIterator returns an Iterator over /views.json
 func (z *Client) GetViewsIterator(ctx context.Context, opts *PaginationOptions) *Iterator[View] {
 	return &Iterator[View]{
 		CommonOptions: opts.CommonOptions,
@@ -26,6 +27,7 @@ func (z *Client) GetViewsIterator(ctx context.Context, opts *PaginationOptions)
 	}
 }
 
+// GetViewsOBP fetches a page of /views.json with offset based pagination
 func (z *Client) GetViewsOBP(ctx context.Context, opts *OBPOptions) ([]View, Page, error) {
 	var data struct {
 		Views []View `json:"views"`
@@ -37,19 +39,19 @@ func (z *Client) GetViewsOBP(ctx context.Context, opts *OBPOptions) ([]View, Pag
 		tmp = &OBPOptions{}
 	}
 
-	u, err := client.AddOptions("/views.json", tmp)
-
+	u, err := tmp.addOptions("/views.json")
 	if err != nil {
 		return nil, Page{}, err
 	}
 
-	err = client.GetData(z, ctx, u, &data)
+	err = z.getData(ctx, u, &data)
 	if err != nil {
 		return nil, Page{}, err
 	}
 	return data.Views, data.Page, nil
 }
 
+// GetViewsCBP fetches a page of /views.json with cursor based pagination
 func (z *Client) GetViewsCBP(ctx context.Context, opts *CBPOptions) ([]View, client.CursorPaginationMeta, error) {
 	var data struct {
 		Views []View                      `json:"views"`
@@ -61,13 +63,12 @@ func (z *Client) GetViewsCBP(ctx context.Context, opts *CBPOptions) ([]View, cli
 		tmp = &CBPOptions{}
 	}
 
-	u, err := client.AddOptions("/views.json", tmp)
-
+	u, err := tmp.addOptions("/views.json")
 	if err != nil {
 		return nil, data.Meta, err
 	}
 
-	err = client.GetData(z, ctx, u, &data)
+	err = z.getData(ctx, u, &data)
 	if err != nil {
 		return nil, data.Meta, err
 	}
@@ -4,14 +4,13 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"time"
 )
 
 // Webhook is struct for webhook payload.
 // https://developer.zendesk.com/api-reference/event-connectors/webhooks/webhooks/
 type Webhook struct {
 	Authentication *WebhookAuthentication `json:"authentication,omitempty"`
-	CreatedAt      time.Time              `json:"created_at,omitempty"`
+	CreatedAt      *Timestamp             `json:"created_at,omitempty"`
 	CreatedBy      string                 `json:"created_by,omitempty"`
 	Description    string                 `json:"description,omitempty"`
 	Endpoint       string                 `json:"endpoint"`
@@ -24,7 +23,7 @@ type Webhook struct {
 	SigningSecret  *WebhookSigningSecret  `json:"signing_secret,omitempty"`
 	Status         string                 `json:"status"`
 	Subscriptions  []string               `json:"subscriptions,omitempty"`
-	UpdatedAt      time.Time              `json:"updated_at,omitempty"`
+	UpdatedAt      *Timestamp             `json:"updated_at,omitempty"`
 	UpdatedBy      string                 `json:"updated_by,omitempty"`
 }
 